@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsBudgetsBudget() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBudgetsBudgetRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"budget_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"limit_amount": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"limit_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_period_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_period_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cost_filters": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"calculated_spend": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actual_spend": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amount": {Type: schema.TypeString, Computed: true},
+									"unit":   {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+						"forecasted_spend": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amount": {Type: schema.TypeString, Computed: true},
+									"unit":   {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"budgeted_and_actual_amounts_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"budgeted_amount": {Type: schema.TypeString, Computed: true},
+						"actual_amount":   {Type: schema.TypeString, Computed: true},
+						"time_period_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_period_end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	budgetName := d.Get("name").(string)
+
+	describeBudgetOutput, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed describing budget: %w", err)
+	}
+
+	budget := describeBudgetOutput.Budget
+
+	d.SetId(fmt.Sprintf("%s:%s", accountID, budgetName))
+	d.Set("account_id", accountID)
+	d.Set("budget_type", budget.BudgetType)
+	d.Set("time_unit", budget.TimeUnit)
+
+	if budget.BudgetLimit != nil {
+		d.Set("limit_amount", budget.BudgetLimit.Amount)
+		d.Set("limit_unit", budget.BudgetLimit.Unit)
+	}
+
+	if budget.TimePeriod != nil {
+		d.Set("time_period_start", budget.TimePeriod.Start.Format(budgetsBudgetTimePeriodLayout))
+		d.Set("time_period_end", budget.TimePeriod.End.Format(budgetsBudgetTimePeriodLayout))
+	}
+
+	if budget.CostFilters != nil {
+		costFilters := make(map[string]string, len(budget.CostFilters))
+		for k, v := range budget.CostFilters {
+			if len(v) > 0 {
+				costFilters[k] = aws.StringValue(v[0])
+			}
+		}
+		d.Set("cost_filters", costFilters)
+	}
+
+	if err := d.Set("calculated_spend", flattenBudgetsCalculatedSpend(budget.CalculatedSpend)); err != nil {
+		return fmt.Errorf("failed setting calculated_spend: %w", err)
+	}
+
+	budgetArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "budgetservice",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("budget/%s", budgetName),
+	}.String()
+	d.Set("arn", budgetArn)
+
+	tags, err := keyvaluetags.BudgetsListTags(conn, budgetArn)
+	if err != nil {
+		return fmt.Errorf("failed listing tags for budget (%s): %w", budgetArn, err)
+	}
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("failed setting tags: %w", err)
+	}
+
+	performanceHistory, err := conn.DescribeBudgetPerformanceHistory(&budgets.DescribeBudgetPerformanceHistoryInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+	if err != nil {
+		// Performance history is only available for COST budgets and recently-created
+		// budgets may not have any history yet; don't fail the read for that.
+		d.Set("budgeted_and_actual_amounts_list", nil)
+		return nil
+	}
+
+	if err := d.Set("budgeted_and_actual_amounts_list", flattenBudgetsBudgetedAndActualAmountsList(performanceHistory.BudgetPerformanceHistory)); err != nil {
+		return fmt.Errorf("failed setting budgeted_and_actual_amounts_list: %w", err)
+	}
+
+	return nil
+}
+
+func flattenBudgetsCalculatedSpend(in *budgets.CalculatedSpend) []map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"actual_spend":     flattenBudgetsSpend(in.ActualSpend),
+			"forecasted_spend": flattenBudgetsSpend(in.ForecastedSpend),
+		},
+	}
+}
+
+func flattenBudgetsSpend(in *budgets.Spend) []map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"amount": aws.StringValue(in.Amount),
+			"unit":   aws.StringValue(in.Unit),
+		},
+	}
+}
+
+func flattenBudgetsBudgetedAndActualAmountsList(in []*budgets.BudgetedAndActualAmounts) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(in))
+	for _, entry := range in {
+		m := map[string]interface{}{}
+
+		if entry.BudgetedAmount != nil {
+			m["budgeted_amount"] = aws.StringValue(entry.BudgetedAmount.Amount)
+		}
+		if entry.ActualAmount != nil {
+			m["actual_amount"] = aws.StringValue(entry.ActualAmount.Amount)
+		}
+		if entry.TimePeriod != nil {
+			m["time_period_start"] = entry.TimePeriod.Start.Format(budgetsBudgetTimePeriodLayout)
+			m["time_period_end"] = entry.TimePeriod.End.Format(budgetsBudgetTimePeriodLayout)
+		}
+
+		out = append(out, m)
+	}
+
+	return out
+}
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsBudgetsBudgetAction() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBudgetsBudgetActionRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"budget_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"action_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"action_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"approval_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_threshold": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_threshold_value": {Type: schema.TypeFloat, Computed: true},
+						"action_threshold_type":  {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"action_definition": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_action_definition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_arn": {Type: schema.TypeString, Computed: true},
+									"groups":     {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+									"roles":      {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+									"users":      {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+								},
+							},
+						},
+						"scp_action_definition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_id":  {Type: schema.TypeString, Computed: true},
+									"target_ids": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+								},
+							},
+						},
+						"ssm_action_definition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_sub_type": {Type: schema.TypeString, Computed: true},
+									"instance_ids":    {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+									"region":          {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"subscriber": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscription_type": {Type: schema.TypeString, Computed: true},
+						"address":           {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	budgetName := d.Get("budget_name").(string)
+	actionID := d.Get("action_id").(string)
+
+	output, err := conn.DescribeBudgetAction(&budgets.DescribeBudgetActionInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+		ActionId:   aws.String(actionID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed describing budget action: %w", err)
+	}
+
+	action := output.Action
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", accountID, budgetName, actionID))
+	d.Set("account_id", accountID)
+	d.Set("action_type", action.ActionType)
+	d.Set("approval_model", action.ApprovalModel)
+	d.Set("execution_role_arn", action.ExecutionRoleArn)
+	d.Set("notification_type", action.NotificationType)
+	d.Set("status", action.Status)
+
+	if err := d.Set("action_threshold", flattenBudgetsActionThreshold(action.ActionThreshold)); err != nil {
+		return fmt.Errorf("failed setting action_threshold: %w", err)
+	}
+
+	if err := d.Set("action_definition", flattenBudgetsActionDefinition(aws.StringValue(action.ActionType), action.Definition)); err != nil {
+		return fmt.Errorf("failed setting action_definition: %w", err)
+	}
+
+	if err := d.Set("subscriber", flattenBudgetsActionSubscribers(action.Subscribers)); err != nil {
+		return fmt.Errorf("failed setting subscriber: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSBudgetsBudgetDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+	dataSourceName := "data.aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetDataSourceConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "budget_type", resourceName, "budget_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "limit_amount", resourceName, "limit_amount"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "limit_unit", resourceName, "limit_unit"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "calculated_spend.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Test", resourceName, "tags.Test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetDataSourceConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  tags = {
+    Test = "true"
+  }
+}
+
+data "aws_budgets_budget" "test" {
+  name = aws_budgets_budget.test.name
+}
+`, rName)
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsMskCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMskClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers_sasl_iam": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers_sasl_scram": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers_tls": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kafka_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_broker_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"zookeeper_connect_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+	clusterName := d.Get("cluster_name").(string)
+
+	var clusterArn string
+	err := conn.ListClustersV2Pages(&kafka.ListClustersV2Input{
+		ClusterNameFilter: aws.String(clusterName),
+	}, func(page *kafka.ListClustersV2Output, lastPage bool) bool {
+		for _, cluster := range page.ClusterInfoList {
+			if aws.StringValue(cluster.ClusterName) == clusterName {
+				clusterArn = aws.StringValue(cluster.ClusterArn)
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing MSK Clusters: %w", err)
+	}
+
+	if clusterArn == "" {
+		return fmt.Errorf("error reading MSK Cluster: no cluster with name %q found", clusterName)
+	}
+
+	out, err := conn.DescribeClusterV2(&kafka.DescribeClusterV2Input{
+		ClusterArn: aws.String(clusterArn),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s): %w", clusterArn, err)
+	}
+
+	cluster := out.ClusterInfo
+
+	d.SetId(aws.StringValue(cluster.ClusterArn))
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("cluster_uuid", mskClusterUuidFromArn(clusterArn))
+
+	if cluster.Provisioned != nil {
+		d.Set("kafka_version", cluster.Provisioned.CurrentBrokerSoftwareInfo.KafkaVersion)
+		d.Set("number_of_broker_nodes", cluster.Provisioned.NumberOfBrokerNodes)
+		d.Set("zookeeper_connect_string", sortMskClusterEndpoints(aws.StringValue(cluster.Provisioned.ZookeeperConnectString)))
+	}
+
+	brokers, err := conn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: aws.String(clusterArn),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting MSK Cluster (%s) bootstrap brokers: %w", clusterArn, err)
+	}
+
+	d.Set("bootstrap_brokers", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerString)))
+	d.Set("bootstrap_brokers_sasl_iam", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerStringSaslIam)))
+	d.Set("bootstrap_brokers_sasl_scram", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerStringSaslScram)))
+	d.Set("bootstrap_brokers_tls", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerStringTls)))
+
+	tags, err := keyvaluetags.KafkaListTags(conn, clusterArn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for MSK Cluster (%s): %w", clusterArn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
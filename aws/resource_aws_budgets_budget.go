@@ -0,0 +1,1026 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const (
+	budgetsBudgetTimePeriodLayout = "2006-01-02_15:04"
+)
+
+func resourceAwsBudgetsBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBudgetsBudgetCreate,
+		Read:   resourceAwsBudgetsBudgetRead,
+		Update: resourceAwsBudgetsBudgetUpdate,
+		Delete: resourceAwsBudgetsBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsBudgetsBudgetCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"budget_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.BudgetTypeUsage,
+					budgets.BudgetTypeCost,
+					budgets.BudgetTypeRiUtilization,
+					budgets.BudgetTypeRiCoverage,
+					budgets.BudgetTypeSavingsPlansUtilization,
+					budgets.BudgetTypeSavingsPlansCoverage,
+				}, false),
+			},
+			"limit_amount": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"planned_limits", "planned_budget_limits"},
+			},
+			"limit_unit": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"planned_limits", "planned_budget_limits"},
+			},
+			"planned_limits": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Deprecated:    "use planned_budget_limits instead",
+				ConflictsWith: []string{"limit_amount", "limit_unit", "planned_budget_limits", "auto_adjust_data"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"amount": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"planned_budget_limits": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"limit_amount", "limit_unit", "planned_limits", "auto_adjust_data"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"amount": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"auto_adjust_data": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"planned_limits", "planned_budget_limits"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_adjust_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.AutoAdjustTypeHistorical,
+								budgets.AutoAdjustTypeForecast,
+							}, false),
+						},
+						"historical_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"budget_adjustment_period": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 60),
+									},
+									"lookback_available_periods": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"time_period_start": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"time_period_end": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"time_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cost_filters": {
+				Type:       schema.TypeMap,
+				Optional:   true,
+				Computed:   true,
+				Elem:       &schema.Schema{Type: schema.TypeString},
+				Deprecated: "use cost_filter instead",
+			},
+			"cost_filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"cost_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_credit": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_discount": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_other_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_recurring": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_refund": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_tax": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_upfront": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"use_amortized": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"use_blended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"notification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ComparisonOperatorGreaterThan,
+								budgets.ComparisonOperatorLessThan,
+								budgets.ComparisonOperatorEqualTo,
+							}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypePercentage,
+								budgets.ThresholdTypeAbsoluteValue,
+							}, false),
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.NotificationTypeActual,
+								budgets.NotificationTypeForecasted,
+							}, false),
+						},
+						"subscriber_email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subscriber_sns_topic_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	budget, err := expandBudgetsBudgetUnmarshal(d)
+	if err != nil {
+		return fmt.Errorf("failed unmarshalling budget: %w", err)
+	}
+	budget.BudgetName = aws.String(resourceAwsBudgetsBudgetName(d))
+
+	_, err = conn.CreateBudget(&budgets.CreateBudgetInput{
+		AccountId: aws.String(accountID),
+		Budget:    budget,
+	})
+	if err != nil {
+		return fmt.Errorf("failed creating budget: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", accountID, aws.StringValue(budget.BudgetName)))
+
+	if err := resourceAwsBudgetsBudgetNotificationsCreate(d, meta); err != nil {
+		return err
+	}
+
+	if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+		budgetArn := resourceAwsBudgetsBudgetArn(meta, accountID, aws.StringValue(budget.BudgetName))
+		if err := keyvaluetags.BudgetsUpdateTags(conn, budgetArn, nil, tags); err != nil {
+			return fmt.Errorf("failed adding tags after creation: %w", err)
+		}
+	}
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	describeBudgetOutput, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+	if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Budget %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed describing budget: %w", err)
+	}
+
+	budget := describeBudgetOutput.Budget
+
+	d.Set("account_id", accountID)
+	d.Set("budget_type", budget.BudgetType)
+	d.Set("name", budget.BudgetName)
+	d.Set("time_unit", budget.TimeUnit)
+
+	if budget.BudgetLimit != nil {
+		d.Set("limit_amount", budget.BudgetLimit.Amount)
+		d.Set("limit_unit", budget.BudgetLimit.Unit)
+	}
+
+	// planned_limits is deprecated in favor of planned_budget_limits; only echo the
+	// API response back into whichever attribute the caller is actually using so a
+	// config that doesn't reference the other one doesn't see a perpetual diff.
+	plannedLimits := flattenBudgetsPlannedLimits(budget.PlannedBudgetLimits)
+	if len(d.Get("planned_limits").([]interface{})) > 0 {
+		if err := d.Set("planned_limits", plannedLimits); err != nil {
+			return fmt.Errorf("failed setting planned_limits: %w", err)
+		}
+	} else {
+		if err := d.Set("planned_budget_limits", plannedLimits); err != nil {
+			return fmt.Errorf("failed setting planned_budget_limits: %w", err)
+		}
+	}
+
+	if err := d.Set("auto_adjust_data", flattenBudgetsAutoAdjustData(budget.AutoAdjustData)); err != nil {
+		return fmt.Errorf("failed setting auto_adjust_data: %w", err)
+	}
+
+	if budget.CostTypes != nil {
+		if err := d.Set("cost_types", flattenBudgetsCostTypes(budget.CostTypes)); err != nil {
+			return fmt.Errorf("failed setting cost_types: %w", err)
+		}
+	}
+
+	if budget.CostFilters != nil {
+		// cost_filters is deprecated in favor of cost_filter, which supports
+		// multi-value dimension and tag filters; only echo the API response back
+		// into whichever attribute the caller is actually using so a config that
+		// doesn't reference the other one doesn't see a perpetual diff.
+		if len(d.Get("cost_filters").(map[string]interface{})) > 0 {
+			costFilters := make(map[string]string, len(budget.CostFilters))
+			for k, v := range budget.CostFilters {
+				if len(v) > 0 {
+					costFilters[k] = aws.StringValue(v[0])
+				}
+			}
+			d.Set("cost_filters", costFilters)
+		} else {
+			if err := d.Set("cost_filter", flattenBudgetsCostFilter(budget.CostFilters)); err != nil {
+				return fmt.Errorf("failed setting cost_filter: %w", err)
+			}
+		}
+	}
+
+	if budget.TimePeriod != nil {
+		d.Set("time_period_start", budget.TimePeriod.Start.Format(budgetsBudgetTimePeriodLayout))
+		d.Set("time_period_end", budget.TimePeriod.End.Format(budgetsBudgetTimePeriodLayout))
+	}
+
+	budgetArn := resourceAwsBudgetsBudgetArn(meta, accountID, budgetName)
+	d.Set("arn", budgetArn)
+
+	if err := resourceAwsBudgetsBudgetNotificationsRead(d, meta); err != nil {
+		return err
+	}
+
+	tags, err := keyvaluetags.BudgetsListTags(conn, budgetArn)
+	if err != nil {
+		return fmt.Errorf("failed listing tags for budget (%s): %w", budgetArn, err)
+	}
+	tags = tags.IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(meta.(*AWSClient).defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("failed setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("failed setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+// resourceAwsBudgetsBudgetArn builds the budgetservice ARN for the given account and
+// budget name; the Budgets API has no ARN field of its own on the Budget struct, so
+// every caller that needs one (tagging, the top-level arn attribute) constructs it
+// the same way.
+func resourceAwsBudgetsBudgetArn(meta interface{}, accountID, budgetName string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "budgetservice",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("budget/%s", budgetName),
+	}.String()
+}
+
+func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	budget, err := expandBudgetsBudgetUnmarshal(d)
+	if err != nil {
+		return fmt.Errorf("could not create budget: %w", err)
+	}
+	budget.BudgetName = aws.String(budgetName)
+
+	_, err = conn.UpdateBudget(&budgets.UpdateBudgetInput{
+		AccountId: aws.String(accountID),
+		NewBudget: budget,
+	})
+	if err != nil {
+		return fmt.Errorf("failed updating budget: %w", err)
+	}
+
+	if err := resourceAwsBudgetsBudgetNotificationsUpdate(d, meta); err != nil {
+		return err
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.BudgetsUpdateTags(conn, resourceAwsBudgetsBudgetArn(meta, accountID, budgetName), o, n); err != nil {
+			return fmt.Errorf("failed updating tags: %w", err)
+		}
+	}
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteBudget(&budgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+	if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed deleting budget: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetName(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("name"); ok {
+		return v.(string)
+	}
+
+	var prefix string
+	if v, ok := d.GetOk("name_prefix"); ok {
+		prefix = v.(string)
+	} else {
+		prefix = resource.UniqueIdPrefix
+	}
+
+	return resource.PrefixedUniqueId(prefix)
+}
+
+func decodeBudgetsBudgetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("budget id must be of the form <account_id>:<budget_name>, got: %s", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandBudgetsBudgetUnmarshal(d *schema.ResourceData) (*budgets.Budget, error) {
+	budgetType := aws.String(d.Get("budget_type").(string))
+	budgetTimeUnit := aws.String(d.Get("time_unit").(string))
+
+	timePeriodStart, err := time.Parse(budgetsBudgetTimePeriodLayout, d.Get("time_period_start").(string))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse time_period_start: %w", err)
+	}
+
+	budget := &budgets.Budget{
+		BudgetType:  budgetType,
+		TimeUnit:    budgetTimeUnit,
+		CostFilters: expandBudgetsCostFiltersMerged(d),
+		CostTypes:   expandBudgetsCostTypes(d),
+		TimePeriod: &budgets.TimePeriod{
+			Start: aws.Time(timePeriodStart),
+		},
+	}
+
+	if v, ok := d.GetOk("time_period_end"); ok {
+		timePeriodEnd, err := time.Parse(budgetsBudgetTimePeriodLayout, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse time_period_end: %w", err)
+		}
+		budget.TimePeriod.End = aws.Time(timePeriodEnd)
+	} else {
+		budget.TimePeriod.End = aws.Time(time.Date(2087, 6, 15, 0, 0, 0, 0, time.UTC))
+	}
+
+	plannedLimitsRaw, hasPlannedLimits := d.GetOk("planned_limits")
+	if !hasPlannedLimits || len(plannedLimitsRaw.([]interface{})) == 0 {
+		plannedLimitsRaw, hasPlannedLimits = d.GetOk("planned_budget_limits")
+	}
+
+	autoAdjustDataRaw, hasAutoAdjustData := d.GetOk("auto_adjust_data")
+
+	if hasPlannedLimits && len(plannedLimitsRaw.([]interface{})) > 0 {
+		plannedLimits, err := expandBudgetsPlannedLimits(plannedLimitsRaw.([]interface{}), aws.StringValue(budgetTimeUnit))
+		if err != nil {
+			return nil, err
+		}
+		budget.PlannedBudgetLimits = plannedLimits
+	} else {
+		amount, amountOk := d.GetOk("limit_amount")
+		unit, unitOk := d.GetOk("limit_unit")
+
+		// RI/Savings Plans utilization and coverage budgets default server-side to a
+		// 100 PERCENTAGE limit, so don't force users to restate it for those types.
+		if !amountOk && budgetTypeIsUtilizationOrCoverage(aws.StringValue(budgetType)) {
+			amount = "100"
+		}
+		if !unitOk && budgetTypeIsUtilizationOrCoverage(aws.StringValue(budgetType)) {
+			unit = budgets.UnitPercentage
+		}
+
+		// With auto_adjust_data set, the API computes limit_amount itself; only send
+		// one if the caller actually configured it.
+		if amountOk || !hasAutoAdjustData {
+			budget.BudgetLimit = &budgets.Spend{
+				Amount: aws.String(amount.(string)),
+				Unit:   aws.String(unit.(string)),
+			}
+		}
+	}
+
+	if hasAutoAdjustData {
+		budget.AutoAdjustData = expandBudgetsAutoAdjustData(autoAdjustDataRaw.([]interface{}))
+	}
+
+	return budget, nil
+}
+
+// budgetTypeIsUtilizationOrCoverage reports whether budgetType tracks a percentage
+// of RI or Savings Plans utilization/coverage rather than a dollar cost or usage
+// amount. These types only support a PERCENTAGE limit_unit.
+func budgetTypeIsUtilizationOrCoverage(budgetType string) bool {
+	switch budgetType {
+	case budgets.BudgetTypeRiUtilization, budgets.BudgetTypeRiCoverage, budgets.BudgetTypeSavingsPlansUtilization, budgets.BudgetTypeSavingsPlansCoverage:
+		return true
+	default:
+		return false
+	}
+}
+
+func expandBudgetsCostFilters(in interface{}) map[string][]*string {
+	costFilters := make(map[string][]*string)
+	for k, v := range in.(map[string]interface{}) {
+		filterValue := v.(string)
+		costFilters[k] = []*string{aws.String(filterValue)}
+	}
+
+	return costFilters
+}
+
+// expandBudgetsCostFiltersMerged combines the deprecated single-value cost_filters
+// map with the cost_filter block, which supports the multi-value dimension and
+// TagKeyValue-style tag filters the CostFilters API actually accepts. Entries in
+// cost_filter take precedence over a same-named entry in cost_filters.
+func expandBudgetsCostFiltersMerged(d *schema.ResourceData) map[string][]*string {
+	costFilters := expandBudgetsCostFilters(d.Get("cost_filters"))
+
+	for _, raw := range d.Get("cost_filter").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		costFilters[m["name"].(string)] = expandStringSet(m["values"].(*schema.Set))
+	}
+
+	return costFilters
+}
+
+func flattenBudgetsCostFilter(in map[string][]*string) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(in))
+	for name, values := range in {
+		out = append(out, map[string]interface{}{
+			"name":   name,
+			"values": aws.StringValueSlice(values),
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i]["name"].(string) < out[j]["name"].(string)
+	})
+
+	return out
+}
+
+func expandBudgetsCostTypes(d *schema.ResourceData) *budgets.CostTypes {
+	costTypesRaw, ok := d.GetOk("cost_types")
+	if !ok {
+		return nil
+	}
+
+	costTypesList := costTypesRaw.([]interface{})
+	if len(costTypesList) == 0 || costTypesList[0] == nil {
+		return nil
+	}
+	costTypes := costTypesList[0].(map[string]interface{})
+
+	return &budgets.CostTypes{
+		IncludeCredit:            aws.Bool(costTypes["include_credit"].(bool)),
+		IncludeDiscount:          aws.Bool(costTypes["include_discount"].(bool)),
+		IncludeOtherSubscription: aws.Bool(costTypes["include_other_subscription"].(bool)),
+		IncludeRecurring:         aws.Bool(costTypes["include_recurring"].(bool)),
+		IncludeRefund:            aws.Bool(costTypes["include_refund"].(bool)),
+		IncludeSubscription:      aws.Bool(costTypes["include_subscription"].(bool)),
+		IncludeSupport:           aws.Bool(costTypes["include_support"].(bool)),
+		IncludeTax:               aws.Bool(costTypes["include_tax"].(bool)),
+		IncludeUpfront:           aws.Bool(costTypes["include_upfront"].(bool)),
+		UseAmortized:             aws.Bool(costTypes["use_amortized"].(bool)),
+		UseBlended:               aws.Bool(costTypes["use_blended"].(bool)),
+	}
+}
+
+func flattenBudgetsCostTypes(costTypes *budgets.CostTypes) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"include_credit":             aws.BoolValue(costTypes.IncludeCredit),
+			"include_discount":           aws.BoolValue(costTypes.IncludeDiscount),
+			"include_other_subscription": aws.BoolValue(costTypes.IncludeOtherSubscription),
+			"include_recurring":          aws.BoolValue(costTypes.IncludeRecurring),
+			"include_refund":             aws.BoolValue(costTypes.IncludeRefund),
+			"include_subscription":       aws.BoolValue(costTypes.IncludeSubscription),
+			"include_support":            aws.BoolValue(costTypes.IncludeSupport),
+			"include_tax":                aws.BoolValue(costTypes.IncludeTax),
+			"include_upfront":            aws.BoolValue(costTypes.IncludeUpfront),
+			"use_amortized":              aws.BoolValue(costTypes.UseAmortized),
+			"use_blended":                aws.BoolValue(costTypes.UseBlended),
+		},
+	}
+}
+
+// expandBudgetsPlannedLimits translates the planned_limits schema list into the
+// API's PlannedBudgetLimits map, which is keyed by the RFC3339 start timestamp of
+// each period. Every entry's start_time must fall on a time_unit boundary so that
+// AWS does not silently reject the budget at apply time.
+func expandBudgetsPlannedLimits(in []interface{}, timeUnit string) (map[string]*budgets.Spend, error) {
+	plannedLimits := make(map[string]*budgets.Spend, len(in))
+
+	for _, raw := range in {
+		m := raw.(map[string]interface{})
+
+		startTime, err := time.Parse(budgetsBudgetTimePeriodLayout, m["start_time"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse planned_limits start_time: %w", err)
+		}
+
+		if err := validateBudgetsPlannedLimitAlignment(startTime, timeUnit); err != nil {
+			return nil, err
+		}
+
+		plannedLimits[startTime.Format(time.RFC3339)] = &budgets.Spend{
+			Amount: aws.String(m["amount"].(string)),
+			Unit:   aws.String(m["unit"].(string)),
+		}
+	}
+
+	return plannedLimits, nil
+}
+
+func validateBudgetsPlannedLimitAlignment(startTime time.Time, timeUnit string) error {
+	switch timeUnit {
+	case budgets.TimeUnitMonthly:
+		if startTime.Day() != 1 {
+			return fmt.Errorf("planned_limits start_time %s must fall on the first day of the month for a MONTHLY time_unit", startTime.Format(budgetsBudgetTimePeriodLayout))
+		}
+	case budgets.TimeUnitQuarterly:
+		if startTime.Day() != 1 || (startTime.Month()-1)%3 != 0 {
+			return fmt.Errorf("planned_limits start_time %s must fall on the first day of a calendar quarter for a QUARTERLY time_unit", startTime.Format(budgetsBudgetTimePeriodLayout))
+		}
+	case budgets.TimeUnitAnnually:
+		if startTime.Day() != 1 || startTime.Month() != time.January {
+			return fmt.Errorf("planned_limits start_time %s must fall on January 1st for an ANNUALLY time_unit", startTime.Format(budgetsBudgetTimePeriodLayout))
+		}
+	}
+
+	return nil
+}
+
+func flattenBudgetsPlannedLimits(in map[string]*budgets.Spend) []map[string]interface{} {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]map[string]interface{}, 0, len(in))
+	for startTime, spend := range in {
+		t, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			continue
+		}
+
+		out = append(out, map[string]interface{}{
+			"start_time": t.Format(budgetsBudgetTimePeriodLayout),
+			"amount":     aws.StringValue(spend.Amount),
+			"unit":       aws.StringValue(spend.Unit),
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i]["start_time"].(string) < out[j]["start_time"].(string)
+	})
+
+	return out
+}
+
+func expandBudgetsAutoAdjustData(in []interface{}) *budgets.AutoAdjustData {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	autoAdjustData := &budgets.AutoAdjustData{
+		AutoAdjustType: aws.String(m["auto_adjust_type"].(string)),
+	}
+
+	if v, ok := m["historical_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		historicalOptions := v[0].(map[string]interface{})
+		autoAdjustData.HistoricalOptions = &budgets.HistoricalOptions{
+			BudgetAdjustmentPeriod: aws.Int64(int64(historicalOptions["budget_adjustment_period"].(int))),
+		}
+	}
+
+	return autoAdjustData
+}
+
+func flattenBudgetsAutoAdjustData(in *budgets.AutoAdjustData) []map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"auto_adjust_type": aws.StringValue(in.AutoAdjustType),
+	}
+
+	if in.HistoricalOptions != nil {
+		m["historical_options"] = []map[string]interface{}{
+			{
+				"budget_adjustment_period":   aws.Int64Value(in.HistoricalOptions.BudgetAdjustmentPeriod),
+				"lookback_available_periods": aws.Int64Value(in.HistoricalOptions.LookBackAvailablePeriods),
+			},
+		}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func resourceAwsBudgetsBudgetNotificationsCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsBudgetsBudgetNotificationsUpdate(d, meta)
+}
+
+func resourceAwsBudgetsBudgetNotificationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	notificationsWithSubscribers, err := resourceAwsBudgetsBudgetNotificationsDescribe(conn, accountID, budgetName)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("notification", flattenBudgetsNotificationsWithSubscribers(notificationsWithSubscribers)); err != nil {
+		return fmt.Errorf("failed setting notification: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetNotificationsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := resourceAwsBudgetsBudgetNotificationsDescribe(conn, accountID, budgetName)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range existing {
+		if _, err := conn.DeleteNotification(&budgets.DeleteNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: n.Notification,
+		}); err != nil {
+			return fmt.Errorf("failed deleting notification: %w", err)
+		}
+	}
+
+	for _, raw := range d.Get("notification").(*schema.Set).List() {
+		notificationWithSubscribers, err := expandBudgetsNotificationWithSubscribers(raw.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+
+		if _, err := conn.CreateNotification(&budgets.CreateNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notificationWithSubscribers.Notification,
+			Subscribers:  notificationWithSubscribers.Subscribers,
+		}); err != nil {
+			return fmt.Errorf("failed creating notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetNotificationsDescribe(conn *budgets.Budgets, accountID, budgetName string) ([]*budgets.NotificationWithSubscribers, error) {
+	var notificationsWithSubscribers []*budgets.NotificationWithSubscribers
+
+	notificationsOutput, err := conn.DescribeNotificationsForBudget(&budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed describing notifications for budget: %w", err)
+	}
+
+	for _, notification := range notificationsOutput.Notifications {
+		subscribersOutput, err := conn.DescribeSubscribersForNotification(&budgets.DescribeSubscribersForNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed describing subscribers for notification: %w", err)
+		}
+
+		notificationsWithSubscribers = append(notificationsWithSubscribers, &budgets.NotificationWithSubscribers{
+			Notification: notification,
+			Subscribers:  subscribersOutput.Subscribers,
+		})
+	}
+
+	return notificationsWithSubscribers, nil
+}
+
+func expandBudgetsNotificationWithSubscribers(in map[string]interface{}) (*budgets.NotificationWithSubscribers, error) {
+	var subscribers []*budgets.Subscriber
+	for _, v := range in["subscriber_email_addresses"].(*schema.Set).List() {
+		subscribers = append(subscribers, &budgets.Subscriber{
+			SubscriptionType: aws.String(budgets.SubscriptionTypeEmail),
+			Address:          aws.String(v.(string)),
+		})
+	}
+	for _, v := range in["subscriber_sns_topic_arns"].(*schema.Set).List() {
+		subscribers = append(subscribers, &budgets.Subscriber{
+			SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+			Address:          aws.String(v.(string)),
+		})
+	}
+
+	if len(subscribers) == 0 {
+		return nil, fmt.Errorf("Notification must have at least one subscriber")
+	}
+
+	return &budgets.NotificationWithSubscribers{
+		Notification: &budgets.Notification{
+			ComparisonOperator: aws.String(in["comparison_operator"].(string)),
+			Threshold:          aws.Float64(in["threshold"].(float64)),
+			ThresholdType:      aws.String(in["threshold_type"].(string)),
+			NotificationType:   aws.String(in["notification_type"].(string)),
+		},
+		Subscribers: subscribers,
+	}, nil
+}
+
+func flattenBudgetsNotificationsWithSubscribers(in []*budgets.NotificationWithSubscribers) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(in))
+
+	for _, notificationWithSubscribers := range in {
+		var emails, topics []string
+		for _, subscriber := range notificationWithSubscribers.Subscribers {
+			switch aws.StringValue(subscriber.SubscriptionType) {
+			case budgets.SubscriptionTypeEmail:
+				emails = append(emails, aws.StringValue(subscriber.Address))
+			case budgets.SubscriptionTypeSns:
+				topics = append(topics, aws.StringValue(subscriber.Address))
+			}
+		}
+
+		out = append(out, map[string]interface{}{
+			"comparison_operator":        aws.StringValue(notificationWithSubscribers.Notification.ComparisonOperator),
+			"threshold":                  aws.Float64Value(notificationWithSubscribers.Notification.Threshold),
+			"threshold_type":             aws.StringValue(notificationWithSubscribers.Notification.ThresholdType),
+			"notification_type":          aws.StringValue(notificationWithSubscribers.Notification.NotificationType),
+			"subscriber_email_addresses": emails,
+			"subscriber_sns_topic_arns":  topics,
+		})
+	}
+
+	return out
+}
+
+func resourceAwsBudgetsBudgetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	budgetType := d.Get("budget_type").(string)
+	if !budgetTypeIsUtilizationOrCoverage(budgetType) {
+		return nil
+	}
+
+	if v, ok := d.GetOk("limit_unit"); ok && v.(string) != budgets.UnitPercentage {
+		return fmt.Errorf("limit_unit must be %q for budget_type %q, got: %q", budgets.UnitPercentage, budgetType, v.(string))
+	}
+
+	// UseBlended has no meaning for RI/Savings Plans utilization and coverage
+	// budgets: those track amortized cost, not the blended/unblended cost metrics
+	// that apply to COST and USAGE budgets.
+	costTypesRaw, ok := d.GetOk("cost_types")
+	if !ok {
+		return nil
+	}
+	costTypesList := costTypesRaw.([]interface{})
+	if len(costTypesList) == 0 || costTypesList[0] == nil {
+		return nil
+	}
+	costTypes := costTypesList[0].(map[string]interface{})
+	if costTypes["use_blended"].(bool) {
+		return fmt.Errorf("cost_types.use_blended is not supported for budget_type %q; use use_amortized instead", budgetType)
+	}
+
+	return nil
+}
@@ -0,0 +1,566 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsBudgetsBudgetAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBudgetsBudgetActionCreate,
+		Read:   resourceAwsBudgetsBudgetActionRead,
+		Update: resourceAwsBudgetsBudgetActionUpdate,
+		Delete: resourceAwsBudgetsBudgetActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBudgetsBudgetActionImport,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"budget_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.ActionTypeApplyIamPolicy,
+					budgets.ActionTypeApplyScpPolicy,
+					budgets.ActionTypeRunSsmDocuments,
+				}, false),
+			},
+			"approval_model": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.ApprovalModelAutomatic,
+					budgets.ApprovalModelManual,
+				}, false),
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.NotificationTypeActual,
+					budgets.NotificationTypeForecasted,
+				}, false),
+			},
+			"action_threshold": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_threshold_value": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"action_threshold_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypeAbsoluteValue,
+								budgets.ThresholdTypePercentage,
+							}, false),
+						},
+					},
+				},
+			},
+			"action_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_action_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"groups": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"roles": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"users": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"scp_action_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"target_ids": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"ssm_action_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_sub_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											budgets.ActionSubTypeStopEc2Instances,
+											budgets.ActionSubTypeStopRdsInstances,
+										}, false),
+									},
+									"instance_ids": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"subscriber": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscription_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.SubscriptionTypeEmail,
+								budgets.SubscriptionTypeSns,
+							}, false),
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsBudgetsBudgetActionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	budgetName := d.Get("budget_name").(string)
+
+	actionThreshold, err := expandBudgetsActionThreshold(d.Get("action_threshold").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	definition, err := expandBudgetsActionDefinition(d.Get("action_type").(string), d.Get("action_definition").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.CreateBudgetActionInput{
+		AccountId:        aws.String(accountID),
+		BudgetName:       aws.String(budgetName),
+		ActionType:       aws.String(d.Get("action_type").(string)),
+		ActionThreshold:  actionThreshold,
+		ApprovalModel:    aws.String(d.Get("approval_model").(string)),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		NotificationType: aws.String(d.Get("notification_type").(string)),
+		Definition:       definition,
+		Subscribers:      expandBudgetsActionSubscribers(d.Get("subscriber").(*schema.Set).List()),
+	}
+
+	output, err := conn.CreateBudgetAction(input)
+	if err != nil {
+		return fmt.Errorf("failed creating budget action: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", accountID, budgetName, aws.StringValue(output.ActionId)))
+
+	if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+		actionArn := resourceAwsBudgetsBudgetActionArn(meta, accountID, budgetName, aws.StringValue(output.ActionId))
+		if err := keyvaluetags.BudgetsUpdateTags(conn, actionArn, nil, tags); err != nil {
+			return fmt.Errorf("failed adding tags after creation: %w", err)
+		}
+	}
+
+	return resourceAwsBudgetsBudgetActionRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, actionID, err := decodeBudgetsBudgetActionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeBudgetAction(&budgets.DescribeBudgetActionInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+		ActionId:   aws.String(actionID),
+	})
+	if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Budget Action %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed describing budget action: %w", err)
+	}
+
+	action := output.Action
+
+	d.Set("account_id", accountID)
+	d.Set("budget_name", budgetName)
+	d.Set("action_id", action.ActionId)
+	d.Set("action_type", action.ActionType)
+	d.Set("approval_model", action.ApprovalModel)
+	d.Set("execution_role_arn", action.ExecutionRoleArn)
+	d.Set("notification_type", action.NotificationType)
+
+	if err := d.Set("action_threshold", flattenBudgetsActionThreshold(action.ActionThreshold)); err != nil {
+		return fmt.Errorf("failed setting action_threshold: %w", err)
+	}
+
+	if err := d.Set("action_definition", flattenBudgetsActionDefinition(aws.StringValue(action.ActionType), action.Definition)); err != nil {
+		return fmt.Errorf("failed setting action_definition: %w", err)
+	}
+
+	if err := d.Set("subscriber", flattenBudgetsActionSubscribers(action.Subscribers)); err != nil {
+		return fmt.Errorf("failed setting subscriber: %w", err)
+	}
+
+	actionArn := resourceAwsBudgetsBudgetActionArn(meta, accountID, budgetName, actionID)
+	d.Set("arn", actionArn)
+
+	tags, err := keyvaluetags.BudgetsListTags(conn, actionArn)
+	if err != nil {
+		return fmt.Errorf("failed listing tags for budget action (%s): %w", actionArn, err)
+	}
+	tags = tags.IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(meta.(*AWSClient).defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("failed setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("failed setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetActionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, actionID, err := decodeBudgetsBudgetActionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	actionThreshold, err := expandBudgetsActionThreshold(d.Get("action_threshold").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.UpdateBudgetAction(&budgets.UpdateBudgetActionInput{
+		AccountId:        aws.String(accountID),
+		BudgetName:       aws.String(budgetName),
+		ActionId:         aws.String(actionID),
+		ActionThreshold:  actionThreshold,
+		ApprovalModel:    aws.String(d.Get("approval_model").(string)),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		NotificationType: aws.String(d.Get("notification_type").(string)),
+		Subscribers:      expandBudgetsActionSubscribers(d.Get("subscriber").(*schema.Set).List()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed updating budget action: %w", err)
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		actionArn := resourceAwsBudgetsBudgetActionArn(meta, accountID, budgetName, actionID)
+		if err := keyvaluetags.BudgetsUpdateTags(conn, actionArn, o, n); err != nil {
+			return fmt.Errorf("failed updating tags: %w", err)
+		}
+	}
+
+	return resourceAwsBudgetsBudgetActionRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetActionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, budgetName, actionID, err := decodeBudgetsBudgetActionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteBudgetAction(&budgets.DeleteBudgetActionInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+		ActionId:   aws.String(actionID),
+	})
+	if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed deleting budget action: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetActionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := decodeBudgetsBudgetActionID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeBudgetsBudgetActionID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("budget action id must be of the form <account_id>:<budget_name>:<action_id>, got: %s", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// resourceAwsBudgetsBudgetActionArn builds the budgetservice ARN for a single budget
+// action, an account/budget/action nested beneath its parent budget's ARN.
+func resourceAwsBudgetsBudgetActionArn(meta interface{}, accountID, budgetName, actionID string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "budgetservice",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("budget/%s/action/%s", budgetName, actionID),
+	}.String()
+}
+
+func expandBudgetsActionThreshold(in []interface{}) (*budgets.ActionThreshold, error) {
+	if len(in) == 0 || in[0] == nil {
+		return nil, fmt.Errorf("action_threshold is required")
+	}
+	m := in[0].(map[string]interface{})
+
+	return &budgets.ActionThreshold{
+		ActionThresholdValue: aws.Float64(m["action_threshold_value"].(float64)),
+		ActionThresholdType:  aws.String(m["action_threshold_type"].(string)),
+	}, nil
+}
+
+func flattenBudgetsActionThreshold(in *budgets.ActionThreshold) []map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"action_threshold_value": aws.Float64Value(in.ActionThresholdValue),
+			"action_threshold_type":  aws.StringValue(in.ActionThresholdType),
+		},
+	}
+}
+
+func expandBudgetsActionDefinition(actionType string, in []interface{}) (*budgets.Definition, error) {
+	if len(in) == 0 || in[0] == nil {
+		return nil, fmt.Errorf("action_definition is required")
+	}
+	m := in[0].(map[string]interface{})
+
+	switch actionType {
+	case budgets.ActionTypeApplyIamPolicy:
+		v, ok := m["iam_action_definition"].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			return nil, fmt.Errorf("iam_action_definition is required when action_type is %s", budgets.ActionTypeApplyIamPolicy)
+		}
+		iam := v[0].(map[string]interface{})
+		return &budgets.Definition{
+			IamActionDefinition: &budgets.IamActionDefinition{
+				PolicyArn: aws.String(iam["policy_arn"].(string)),
+				Groups:    expandStringList(iam["groups"].([]interface{})),
+				Roles:     expandStringList(iam["roles"].([]interface{})),
+				Users:     expandStringList(iam["users"].([]interface{})),
+			},
+		}, nil
+	case budgets.ActionTypeApplyScpPolicy:
+		v, ok := m["scp_action_definition"].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			return nil, fmt.Errorf("scp_action_definition is required when action_type is %s", budgets.ActionTypeApplyScpPolicy)
+		}
+		scp := v[0].(map[string]interface{})
+		return &budgets.Definition{
+			ScpActionDefinition: &budgets.ScpActionDefinition{
+				PolicyId:  aws.String(scp["policy_id"].(string)),
+				TargetIds: expandStringList(scp["target_ids"].([]interface{})),
+			},
+		}, nil
+	case budgets.ActionTypeRunSsmDocuments:
+		v, ok := m["ssm_action_definition"].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			return nil, fmt.Errorf("ssm_action_definition is required when action_type is %s", budgets.ActionTypeRunSsmDocuments)
+		}
+		ssm := v[0].(map[string]interface{})
+		return &budgets.Definition{
+			SsmActionDefinition: &budgets.SsmActionDefinition{
+				ActionSubType: aws.String(ssm["action_sub_type"].(string)),
+				InstanceIds:   expandStringList(ssm["instance_ids"].([]interface{})),
+				Region:        aws.String(ssm["region"].(string)),
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported action_type: %s", actionType)
+	}
+}
+
+func flattenBudgetsActionDefinition(actionType string, in *budgets.Definition) []map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+
+	def := map[string]interface{}{}
+
+	switch actionType {
+	case budgets.ActionTypeApplyIamPolicy:
+		if in.IamActionDefinition != nil {
+			def["iam_action_definition"] = []map[string]interface{}{
+				{
+					"policy_arn": aws.StringValue(in.IamActionDefinition.PolicyArn),
+					"groups":     aws.StringValueSlice(in.IamActionDefinition.Groups),
+					"roles":      aws.StringValueSlice(in.IamActionDefinition.Roles),
+					"users":      aws.StringValueSlice(in.IamActionDefinition.Users),
+				},
+			}
+		}
+	case budgets.ActionTypeApplyScpPolicy:
+		if in.ScpActionDefinition != nil {
+			def["scp_action_definition"] = []map[string]interface{}{
+				{
+					"policy_id":  aws.StringValue(in.ScpActionDefinition.PolicyId),
+					"target_ids": aws.StringValueSlice(in.ScpActionDefinition.TargetIds),
+				},
+			}
+		}
+	case budgets.ActionTypeRunSsmDocuments:
+		if in.SsmActionDefinition != nil {
+			def["ssm_action_definition"] = []map[string]interface{}{
+				{
+					"action_sub_type": aws.StringValue(in.SsmActionDefinition.ActionSubType),
+					"instance_ids":    aws.StringValueSlice(in.SsmActionDefinition.InstanceIds),
+					"region":          aws.StringValue(in.SsmActionDefinition.Region),
+				},
+			}
+		}
+	}
+
+	return []map[string]interface{}{def}
+}
+
+func expandBudgetsActionSubscribers(in []interface{}) []*budgets.Subscriber {
+	subscribers := make([]*budgets.Subscriber, 0, len(in))
+	for _, raw := range in {
+		m := raw.(map[string]interface{})
+		subscribers = append(subscribers, &budgets.Subscriber{
+			SubscriptionType: aws.String(m["subscription_type"].(string)),
+			Address:          aws.String(m["address"].(string)),
+		})
+	}
+
+	return subscribers
+}
+
+func flattenBudgetsActionSubscribers(in []*budgets.Subscriber) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(in))
+	for _, subscriber := range in {
+		out = append(out, map[string]interface{}{
+			"subscription_type": aws.StringValue(subscriber.SubscriptionType),
+			"address":           aws.StringValue(subscriber.Address),
+		})
+	}
+
+	return out
+}
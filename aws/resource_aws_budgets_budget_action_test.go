@@ -0,0 +1,498 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
+		Name: "aws_budgets_budget_action",
+		F:    testSweepBudgetsBudgetActions,
+	})
+}
+
+func testSweepBudgetsBudgetActions(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).budgetconn
+	accountID := client.(*AWSClient).accountid
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeBudgetsPages(&budgets.DescribeBudgetsInput{AccountId: aws.String(accountID)}, func(page *budgets.DescribeBudgetsOutput, lastPage bool) bool {
+		for _, b := range page.Budgets {
+			budgetName := aws.StringValue(b.BudgetName)
+
+			actionsOutput, err := conn.DescribeBudgetActionsForBudget(&budgets.DescribeBudgetActionsForBudgetInput{
+				AccountId:  aws.String(accountID),
+				BudgetName: aws.String(budgetName),
+			})
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Budget Actions for %s: %w", budgetName, err))
+				continue
+			}
+
+			for _, action := range actionsOutput.Actions {
+				log.Printf("[INFO] Deleting Budget Action: %s", aws.StringValue(action.ActionId))
+				_, err := conn.DeleteBudgetAction(&budgets.DeleteBudgetActionInput{
+					AccountId:  aws.String(accountID),
+					BudgetName: aws.String(budgetName),
+					ActionId:   action.ActionId,
+				})
+				if err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting Budget Action (%s): %w", aws.StringValue(action.ActionId), err))
+				}
+			}
+		}
+		return !lastPage
+	})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Budgets Budget Action sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Budgets: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSBudgetsBudgetAction_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_type", budgets.ActionTypeApplyIamPolicy),
+					resource.TestCheckResourceAttr(resourceName, "approval_model", budgets.ApprovalModelAutomatic),
+					resource.TestCheckResourceAttr(resourceName, "notification_type", budgets.NotificationTypeActual),
+					resource.TestCheckResourceAttr(resourceName, "action_definition.0.iam_action_definition.0.roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudgetAction_scpAction(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionConfig_ScpAction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_type", budgets.ActionTypeApplyScpPolicy),
+					resource.TestCheckResourceAttr(resourceName, "action_definition.0.scp_action_definition.0.target_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudgetAction_ssmAction(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionConfig_SsmAction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_type", budgets.ActionTypeRunSsmDocuments),
+					resource.TestCheckResourceAttr(resourceName, "action_definition.0.ssm_action_definition.0.action_sub_type", budgets.ActionSubTypeStopEc2Instances),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudgetActionDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget_action.test"
+	dataSourceName := "data.aws_budgets_budget_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionDataSourceConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "action_type", resourceName, "action_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "approval_model", resourceName, "approval_model"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "execution_role_arn", resourceName, "execution_role_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudgetAction_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetActionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsBudgetsBudgetAction(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetActionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		accountID, budgetName, actionID, err := decodeBudgetsBudgetActionID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed decoding ID: %w", err)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).budgetconn
+		_, err = conn.DescribeBudgetAction(&budgets.DescribeBudgetActionInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(budgetName),
+			ActionId:   aws.String(actionID),
+		})
+		if err != nil {
+			return fmt.Errorf("error describing budget action: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSBudgetsBudgetActionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).budgetconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_budgets_budget_action" {
+			continue
+		}
+
+		accountID, budgetName, actionID, err := decodeBudgetsBudgetActionID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("budget action '%s': id could not be decoded and could not be deleted properly", rs.Primary.ID)
+		}
+
+		_, err = conn.DescribeBudgetAction(&budgets.DescribeBudgetActionInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(budgetName),
+			ActionId:   aws.String(actionID),
+		})
+		if !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return fmt.Errorf("budget action '%s' was not deleted properly", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSBudgetsBudgetActionConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "budgets.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "ec2:RunInstances",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    threshold                  = 100
+    threshold_type             = "PERCENTAGE"
+    notification_type          = "ACTUAL"
+    subscriber_email_addresses = ["test@example.com"]
+  }
+}
+
+resource "aws_budgets_budget_action" "test" {
+  budget_name        = aws_budgets_budget.test.name
+  action_type        = "APPLY_IAM_POLICY"
+  approval_model     = "AUTOMATIC"
+  notification_type  = "ACTUAL"
+  execution_role_arn = aws_iam_role.test.arn
+
+  action_threshold {
+    action_threshold_type  = "PERCENTAGE"
+    action_threshold_value = 100
+  }
+
+  action_definition {
+    iam_action_definition {
+      policy_arn = aws_iam_policy.test.arn
+      roles      = [aws_iam_role.test.name]
+    }
+  }
+
+  subscriber {
+    subscription_type = "EMAIL"
+    address           = "test@example.com"
+  }
+}
+`, rName)
+}
+
+func testAccAWSBudgetsBudgetActionConfig_ScpAction(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "budgets.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    threshold                  = 100
+    threshold_type             = "PERCENTAGE"
+    notification_type          = "ACTUAL"
+    subscriber_email_addresses = ["test@example.com"]
+  }
+}
+
+resource "aws_budgets_budget_action" "test" {
+  budget_name        = aws_budgets_budget.test.name
+  action_type        = "APPLY_SCP_POLICY"
+  approval_model     = "AUTOMATIC"
+  notification_type  = "ACTUAL"
+  execution_role_arn = aws_iam_role.test.arn
+
+  action_threshold {
+    action_threshold_type  = "PERCENTAGE"
+    action_threshold_value = 100
+  }
+
+  action_definition {
+    scp_action_definition {
+      policy_id  = "p-FullAWSAccess"
+      target_ids = [data.aws_organizations_organization.test.roots[0].id]
+    }
+  }
+
+  subscriber {
+    subscription_type = "EMAIL"
+    address           = "test@example.com"
+  }
+}
+`, rName)
+}
+
+func testAccAWSBudgetsBudgetActionConfig_SsmAction(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "budgets.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+data "aws_region" "current" {}
+
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t2.micro"
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    threshold                  = 100
+    threshold_type             = "PERCENTAGE"
+    notification_type          = "ACTUAL"
+    subscriber_email_addresses = ["test@example.com"]
+  }
+}
+
+resource "aws_budgets_budget_action" "test" {
+  budget_name        = aws_budgets_budget.test.name
+  action_type        = "RUN_SSM_DOCUMENTS"
+  approval_model     = "AUTOMATIC"
+  notification_type  = "ACTUAL"
+  execution_role_arn = aws_iam_role.test.arn
+
+  action_threshold {
+    action_threshold_type  = "PERCENTAGE"
+    action_threshold_value = 100
+  }
+
+  action_definition {
+    ssm_action_definition {
+      action_sub_type = "STOP_EC2_INSTANCES"
+      instance_ids    = [aws_instance.test.id]
+      region          = data.aws_region.current.name
+    }
+  }
+
+  subscriber {
+    subscription_type = "EMAIL"
+    address           = "test@example.com"
+  }
+}
+`, rName)
+}
+
+func testAccAWSBudgetsBudgetActionDataSourceConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_caller_identity" "current" {}
+
+data "aws_budgets_budget_action" "test" {
+  account_id  = data.aws_caller_identity.current.account_id
+  budget_name = aws_budgets_budget_action.test.budget_name
+  action_id   = aws_budgets_budget_action.test.action_id
+}
+`, testAccAWSBudgetsBudgetActionConfig_Basic(rName))
+}
@@ -131,6 +131,306 @@ func TestAccAWSBudgetsBudget_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSBudgetsBudget_tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetConfig_Tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSBudgetsBudgetConfig_Tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func TestAccAWSBudgetsBudget_autoAdjustData(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_AutoAdjustDataHistorical(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.auto_adjust_type", "HISTORICAL"),
+					resource.TestCheckResourceAttr(resourceName, "auto_adjust_data.0.historical_options.0.budget_adjustment_period", "6"),
+					resource.TestCheckResourceAttrSet(resourceName, "limit_amount"),
+				),
+			},
+			{
+				// Plan must be stable once the server has computed limit_amount.
+				Config:   testAccAWSBudgetsBudgetConfig_AutoAdjustDataHistorical(rName, 6),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+			{
+				Config:      testAccAWSBudgetsBudgetConfig_AutoAdjustDataConflictsPlannedBudgetLimits(rName),
+				ExpectError: regexp.MustCompile("conflicts with"),
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetConfig_AutoAdjustDataHistorical(rName string, budgetAdjustmentPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "HISTORICAL"
+
+    historical_options {
+      budget_adjustment_period = %[2]d
+    }
+  }
+}
+`, rName, budgetAdjustmentPeriod)
+}
+
+func testAccAWSBudgetsBudgetConfig_AutoAdjustDataConflictsPlannedBudgetLimits(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "HISTORICAL"
+
+    historical_options {
+      budget_adjustment_period = 6
+    }
+  }
+
+  planned_budget_limits {
+    start_time = "2017-01-01_00:00"
+    amount     = "100.0"
+    unit       = "USD"
+  }
+}
+`, rName)
+}
+
+func TestAccAWSBudgetsBudget_costFilter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_CostFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "budgetservice", fmt.Sprintf(`budget/%s`, rName)),
+					resource.TestCheckResourceAttr(resourceName, "cost_filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cost_filter.*", map[string]string{
+						"name": "TagKeyValue",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+			{
+				// AWS may return the underlying value lists in a different order than
+				// configured; the plan must stay stable when nothing actually changed.
+				Config:   testAccAWSBudgetsBudgetConfig_CostFilter(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetConfig_CostFilter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  cost_filter {
+    name   = "Service"
+    values = ["Amazon Elastic Compute Cloud - Compute", "Amazon Simple Storage Service"]
+  }
+
+  cost_filter {
+    name   = "TagKeyValue"
+    values = ["user:Team$Platform", "user:Team$Data"]
+  }
+}
+`, rName)
+}
+
+func TestAccAWSBudgetsBudget_plannedLimits(t *testing.T) {
+	costFilterKey := "AZ"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	configBasicDefaults := testAccAWSBudgetsBudgetConfigDefaults(rName)
+	// The planned-limit configs start the budget at its first planned period.
+	configBasicDefaults.TimePeriod.Start = aws.Time(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_PlannedLimits(configBasicDefaults, costFilterKey, []string{"100.0", "200.0"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetExists(resourceName, configBasicDefaults),
+					resource.TestCheckResourceAttr(resourceName, "planned_limits.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "planned_limits.0.amount", "100.0"),
+					resource.TestCheckResourceAttr(resourceName, "planned_limits.1.amount", "200.0"),
+				),
+			},
+			{
+				// Changing the planned amounts and adding a third planned period should not force recreation.
+				Config: testAccAWSBudgetsBudgetConfig_PlannedLimits(configBasicDefaults, costFilterKey, []string{"200.0", "300.0", "400.0"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetExists(resourceName, configBasicDefaults),
+					resource.TestCheckResourceAttr(resourceName, "planned_limits.#", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+			{
+				// Plan must be stable across successive applies with no config change.
+				Config:   testAccAWSBudgetsBudgetConfig_PlannedLimits(configBasicDefaults, costFilterKey, []string{"200.0", "300.0", "400.0"}),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudget_utilizationAndCoverageTypes(t *testing.T) {
+	resourceName := "aws_budgets_budget.test"
+
+	for _, budgetType := range []string{
+		budgets.BudgetTypeRiUtilization,
+		budgets.BudgetTypeRiCoverage,
+		budgets.BudgetTypeSavingsPlansUtilization,
+		budgets.BudgetTypeSavingsPlansCoverage,
+	} {
+		budgetType := budgetType
+		t.Run(budgetType, func(t *testing.T) {
+			rName := acctest.RandomWithPrefix("tf-acc-test")
+
+			resource.ParallelTest(t, resource.TestCase{
+				PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+				Providers:    testAccProviders,
+				CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccAWSBudgetsBudgetConfig_UtilizationOrCoverage(rName, budgetType),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "budget_type", budgetType),
+							resource.TestCheckResourceAttr(resourceName, "limit_amount", "100"),
+							resource.TestCheckResourceAttr(resourceName, "limit_unit", budgets.UnitPercentage),
+							resource.TestCheckResourceAttr(resourceName, "cost_types.0.use_amortized", "true"),
+						),
+					},
+					{
+						ResourceName:            resourceName,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"name_prefix"},
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAccAWSBudgetsBudget_prefix(t *testing.T) {
 	costFilterKey := "AZ"
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -600,6 +900,136 @@ resource "aws_budgets_budget" "test" {
 `, aws.StringValue(budgetConfig.BudgetName), aws.StringValue(budgetConfig.BudgetType), aws.StringValue(budgetConfig.BudgetLimit.Amount), aws.StringValue(budgetConfig.BudgetLimit.Unit), timePeriodStart, aws.StringValue(budgetConfig.TimeUnit), costFilterKey, costFilterValue)
 }
 
+func testAccAWSBudgetsBudgetConfig_UtilizationOrCoverage(rName, budgetType string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = "%s"
+  budget_type       = "%s"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  cost_filters = {
+    "LinkedAccount" = "012345678910"
+  }
+
+  cost_types {
+    use_amortized = true
+    use_blended   = false
+  }
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    threshold                  = 100
+    threshold_type             = "PERCENTAGE"
+    notification_type          = "ACTUAL"
+    subscriber_email_addresses = ["test@example.com"]
+  }
+}
+`, rName, budgetType)
+}
+
+func TestAccAWSBudgetsBudget_plannedBudgetLimits(t *testing.T) {
+	costFilterKey := "AZ"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	configBasicDefaults := testAccAWSBudgetsBudgetConfigDefaults(rName)
+	// The planned-limit configs start the budget at its first planned period.
+	configBasicDefaults.TimePeriod.Start = aws.Time(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_PlannedBudgetLimitsQuarterly(configBasicDefaults, costFilterKey, []string{"100.0", "200.0", "300.0"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetExists(resourceName, configBasicDefaults),
+					resource.TestCheckResourceAttr(resourceName, "planned_budget_limits.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "planned_budget_limits.0.amount", "100.0"),
+					resource.TestCheckResourceAttr(resourceName, "planned_budget_limits.2.amount", "300.0"),
+					resource.TestCheckResourceAttr(resourceName, "planned_limits.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+			{
+				// Plan must be stable across successive applies with no config change.
+				Config:   testAccAWSBudgetsBudgetConfig_PlannedBudgetLimitsQuarterly(configBasicDefaults, costFilterKey, []string{"100.0", "200.0", "300.0"}),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetConfig_PlannedBudgetLimitsQuarterly(budgetConfig budgets.Budget, costFilterKey string, quarterlyAmounts []string) string {
+	costFilterValue := aws.StringValue(budgetConfig.CostFilters[costFilterKey][0])
+
+	plannedLimitBlocks := make([]string, len(quarterlyAmounts))
+	periodStart := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i, amount := range quarterlyAmounts {
+		plannedLimitBlocks[i] = fmt.Sprintf(`
+  planned_budget_limits {
+    start_time = "%s"
+    amount     = "%s"
+    unit       = "USD"
+  }
+`, periodStart.AddDate(0, i*3, 0).Format("2006-01-02_15:04"), amount)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = "%s"
+  budget_type = "%s"
+  time_unit   = "%s"
+
+  %s
+
+  time_period_start = "%s"
+
+  cost_filters = {
+    "%s" = "%s"
+  }
+}
+`, aws.StringValue(budgetConfig.BudgetName), aws.StringValue(budgetConfig.BudgetType), aws.StringValue(budgetConfig.TimeUnit), strings.Join(plannedLimitBlocks, "\n"), periodStart.Format("2006-01-02_15:04"), costFilterKey, costFilterValue)
+}
+
+func testAccAWSBudgetsBudgetConfig_PlannedLimits(budgetConfig budgets.Budget, costFilterKey string, monthlyAmounts []string) string {
+	costFilterValue := aws.StringValue(budgetConfig.CostFilters[costFilterKey][0])
+
+	plannedLimitBlocks := make([]string, len(monthlyAmounts))
+	periodStart := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i, amount := range monthlyAmounts {
+		plannedLimitBlocks[i] = fmt.Sprintf(`
+  planned_limits {
+    start_time = "%s"
+    amount     = "%s"
+    unit       = "USD"
+  }
+`, periodStart.AddDate(0, i, 0).Format("2006-01-02_15:04"), amount)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = "%s"
+  budget_type = "%s"
+  time_unit   = "%s"
+
+  %s
+
+  time_period_start = "%s"
+
+  cost_filters = {
+    "%s" = "%s"
+  }
+}
+`, aws.StringValue(budgetConfig.BudgetName), aws.StringValue(budgetConfig.BudgetType), aws.StringValue(budgetConfig.TimeUnit), strings.Join(plannedLimitBlocks, "\n"), periodStart.Format("2006-01-02_15:04"), costFilterKey, costFilterValue)
+}
+
 func testAccAWSBudgetsBudgetConfig_Basic(budgetConfig budgets.Budget, costFilterKey string) string {
 	timePeriodStart := budgetConfig.TimePeriod.Start.Format("2006-01-02_15:04")
 	timePeriodEnd := budgetConfig.TimePeriod.End.Format("2006-01-02_15:04")
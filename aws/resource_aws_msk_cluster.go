@@ -0,0 +1,1454 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMskCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskClusterCreate,
+		Read:   resourceAwsMskClusterRead,
+		Update: resourceAwsMskClusterUpdate,
+		Delete: resourceAwsMskClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			validateMskClusterLoggingInfo,
+			validateMskClusterClientAuthenticationSaslIam,
+			validateMskClusterBrokerNodeGroupInfoProvisionedThroughput,
+			validateMskClusterEncryptionInTransitPlaintext,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// This is the resource-level escape hatch for
+			// validateMskClusterEncryptionInTransitPlaintext. A provider-level
+			// msk_require_encryption default would let operators flip the
+			// default for every cluster at once, but that belongs on the
+			// Provider schema rather than here.
+			"allow_plaintext_in_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers_sasl_iam": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers_sasl_scram": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers_tls": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"broker_node_group_info": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az_distribution": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      kafka.BrokerAZDistributionDefault,
+							ValidateFunc: validation.StringInSlice(kafka.BrokerAZDistribution_Values(), false),
+						},
+						"client_subnets": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ebs_volume_size": {
+							Type: schema.TypeInt,
+							// Computed so that, when ebs_volume_size is left unset, volume
+							// growth performed out-of-band by the
+							// ebs_storage_info.0.volume_size_autoscaling target-tracking
+							// policy isn't reverted on the next plan. A configured value
+							// is still planned back over a grown volume.
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"ebs_storage_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"volume_size_autoscaling": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"target_tracking_utilization_percentage": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 100),
+												},
+												"max_volume_size_in_gib": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"scale_in_cooldown": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      0,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"provisioned_throughput": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"volume_throughput": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(250),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"client_authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sasl": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iam": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"scram": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+						"tls": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_authority_arns": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cluster_name_prefix"},
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]{0,63}$`), "name must consist of alphanumeric characters or hyphens, start with a letter or digit, and be at most 64 characters"),
+			},
+			"cluster_name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cluster_name"},
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]{0,41}$`), "name prefix must consist of alphanumeric characters or hyphens, start with a letter or digit, and be at most 42 characters"),
+			},
+			"configuration_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"revision": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_at_rest_kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"encryption_in_transit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_broker": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      kafka.ClientBrokerTls,
+										ValidateFunc: validation.StringInSlice(kafka.ClientBroker_Values(), false),
+									},
+									"in_cluster": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"enhanced_monitoring": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kafka.EnhancedMonitoringDefault,
+				ValidateFunc: validation.StringInSlice(kafka.EnhancedMonitoring_Values(), false),
+			},
+			"kafka_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"logging_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"broker_logs": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloudwatch_logs": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"log_group": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"firehose": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"delivery_stream": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"bucket": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"number_of_broker_nodes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"open_monitoring": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prometheus": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"jmx_exporter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled_in_broker": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+									"node_exporter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled_in_broker": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"zookeeper_connect_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMskClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	input := &kafka.CreateClusterInput{
+		ClusterName:         aws.String(resourceAwsMskClusterName(d)),
+		KafkaVersion:        aws.String(d.Get("kafka_version").(string)),
+		NumberOfBrokerNodes: aws.Int64(int64(d.Get("number_of_broker_nodes").(int))),
+		BrokerNodeGroupInfo: expandMskClusterBrokerNodeGroupInfo(d.Get("broker_node_group_info").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("client_authentication"); ok {
+		input.ClientAuthentication = expandMskClusterClientAuthentication(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("configuration_info"); ok {
+		input.ConfigurationInfo = expandMskClusterConfigurationInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("encryption_info"); ok {
+		input.EncryptionInfo = expandMskClusterEncryptionInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("enhanced_monitoring"); ok {
+		input.EnhancedMonitoring = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging_info"); ok {
+		input.LoggingInfo = expandMskClusterLoggingInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("open_monitoring"); ok {
+		input.OpenMonitoring = expandMskClusterOpenMonitoring(v.([]interface{}))
+	}
+
+	if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().KafkaTags()
+	}
+
+	log.Printf("[DEBUG] Creating MSK Cluster: %s", input)
+	output, err := conn.CreateCluster(input)
+	if err != nil {
+		return fmt.Errorf("error creating MSK Cluster: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ClusterArn))
+
+	if err := resourceAwsMskClusterCreateWaiter(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MSK Cluster (%s) create: %w", d.Id(), err)
+	}
+
+	if err := resourceAwsMskClusterSetBrokerStorageAutoScaling(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsMskClusterRead(d, meta)
+}
+
+func resourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	out, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MSK Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s): %w", d.Id(), err)
+	}
+
+	cluster := out.ClusterInfo
+
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("current_version", cluster.CurrentVersion)
+	d.Set("enhanced_monitoring", cluster.EnhancedMonitoring)
+	d.Set("kafka_version", cluster.CurrentBrokerSoftwareInfo.KafkaVersion)
+	d.Set("number_of_broker_nodes", cluster.NumberOfBrokerNodes)
+
+	ebsStorageInfo, err := flattenMskClusterBrokerStorageAutoScaling(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("broker_node_group_info", flattenMskClusterBrokerNodeGroupInfo(cluster.BrokerNodeGroupInfo, ebsStorageInfo)); err != nil {
+		return fmt.Errorf("error setting broker_node_group_info: %w", err)
+	}
+
+	if err := d.Set("client_authentication", flattenMskClusterClientAuthentication(cluster.ClientAuthentication)); err != nil {
+		return fmt.Errorf("error setting client_authentication: %w", err)
+	}
+
+	if cluster.CurrentBrokerSoftwareInfo.ConfigurationArn != nil {
+		if err := d.Set("configuration_info", flattenMskClusterConfigurationInfo(cluster.CurrentBrokerSoftwareInfo)); err != nil {
+			return fmt.Errorf("error setting configuration_info: %w", err)
+		}
+	}
+
+	if err := d.Set("encryption_info", flattenMskClusterEncryptionInfo(cluster.EncryptionInfo)); err != nil {
+		return fmt.Errorf("error setting encryption_info: %w", err)
+	}
+
+	if err := d.Set("logging_info", flattenMskClusterLoggingInfo(cluster.LoggingInfo)); err != nil {
+		return fmt.Errorf("error setting logging_info: %w", err)
+	}
+
+	if err := d.Set("open_monitoring", flattenMskClusterOpenMonitoring(cluster.OpenMonitoring)); err != nil {
+		return fmt.Errorf("error setting open_monitoring: %w", err)
+	}
+
+	brokers, err := conn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting MSK Cluster (%s) bootstrap brokers: %w", d.Id(), err)
+	}
+
+	d.Set("bootstrap_brokers", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerString)))
+	d.Set("bootstrap_brokers_sasl_iam", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerStringSaslIam)))
+	d.Set("bootstrap_brokers_sasl_scram", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerStringSaslScram)))
+	d.Set("bootstrap_brokers_tls", sortMskClusterEndpoints(aws.StringValue(brokers.BootstrapBrokerStringTls)))
+
+	d.Set("zookeeper_connect_string", sortMskClusterEndpoints(aws.StringValue(cluster.ZookeeperConnectString)))
+
+	tags, err := keyvaluetags.KafkaListTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for MSK Cluster (%s): %w", d.Id(), err)
+	}
+	tags = tags.IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(meta.(*AWSClient).defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	if d.HasChange("kafka_version") || d.HasChange("configuration_info") {
+		input := &kafka.UpdateClusterKafkaVersionInput{
+			ClusterArn:         aws.String(d.Id()),
+			CurrentVersion:     aws.String(d.Get("current_version").(string)),
+			TargetKafkaVersion: aws.String(d.Get("kafka_version").(string)),
+		}
+
+		if v, ok := d.GetOk("configuration_info"); ok {
+			input.ConfigurationInfo = expandMskClusterConfigurationInfo(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MSK Cluster (%s) Kafka version: %s", d.Id(), input)
+		_, err := conn.UpdateClusterKafkaVersion(input)
+		if err != nil {
+			return fmt.Errorf("error updating MSK Cluster (%s) Kafka version: %w", d.Id(), err)
+		}
+
+		if err := resourceAwsMskClusterUpdateWaiter(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MSK Cluster (%s) Kafka version update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("broker_node_group_info.0.ebs_volume_size") || d.HasChange("broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput") {
+		brokerEBSVolumeInfo := &kafka.BrokerEBSVolumeInfo{
+			KafkaBrokerNodeId: aws.String("All"),
+			VolumeSizeGB:      aws.Int64(int64(d.Get("broker_node_group_info.0.ebs_volume_size").(int))),
+		}
+
+		if pt := expandMskClusterProvisionedThroughput(d.Get("broker_node_group_info.0.ebs_storage_info").([]interface{})); pt != nil {
+			brokerEBSVolumeInfo.ProvisionedThroughput = pt
+		}
+
+		// The Kafka version update above, if it ran, already invalidated the
+		// current_version token cached in state, so re-fetch it before issuing
+		// another mutating call against the cluster.
+		currentVersion, err := mskClusterCurrentVersion(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error reading MSK Cluster (%s) current version: %w", d.Id(), err)
+		}
+
+		_, err = conn.UpdateBrokerStorage(&kafka.UpdateBrokerStorageInput{
+			ClusterArn:                aws.String(d.Id()),
+			CurrentVersion:            aws.String(currentVersion),
+			TargetBrokerEBSVolumeInfo: []*kafka.BrokerEBSVolumeInfo{brokerEBSVolumeInfo},
+		})
+		if err != nil {
+			return fmt.Errorf("error updating MSK Cluster (%s) broker storage: %w", d.Id(), err)
+		}
+
+		if err := resourceAwsMskClusterUpdateWaiter(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MSK Cluster (%s) broker storage update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("broker_node_group_info.0.ebs_storage_info.0.volume_size_autoscaling") {
+		if len(d.Get("broker_node_group_info.0.ebs_storage_info.0.volume_size_autoscaling").([]interface{})) == 0 {
+			if err := resourceAwsMskClusterDeregisterBrokerStorageAutoScaling(d, meta); err != nil {
+				return err
+			}
+		} else if err := resourceAwsMskClusterSetBrokerStorageAutoScaling(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.KafkaUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags for MSK Cluster (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMskClusterRead(d, meta)
+}
+
+func resourceAwsMskClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	if len(d.Get("broker_node_group_info.0.ebs_storage_info").([]interface{})) > 0 {
+		if err := resourceAwsMskClusterDeregisterBrokerStorageAutoScaling(d, meta); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MSK Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Cluster (%s): %w", d.Id(), err)
+	}
+
+	if err := resourceAwsMskClusterDeleteWaiter(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MSK Cluster (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMskClusterCreateWaiter(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateCreating},
+		Target:  []string{kafka.ClusterStateActive},
+		Refresh: mskClusterStateRefresh(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceAwsMskClusterUpdateWaiter(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateUpdating},
+		Target:  []string{kafka.ClusterStateActive},
+		Refresh: mskClusterStateRefresh(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func mskClusterCurrentVersion(conn *kafka.Kafka, arn string) (string, error) {
+	out, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+		ClusterArn: aws.String(arn),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(out.ClusterInfo.CurrentVersion), nil
+}
+
+func mskClusterStateRefresh(conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+			ClusterArn: aws.String(arn),
+		})
+		if err != nil {
+			return out, "", err
+		}
+
+		return out, aws.StringValue(out.ClusterInfo.State), nil
+	}
+}
+
+func resourceAwsMskClusterDeleteWaiter(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateDeleting},
+		Target:  []string{},
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+				ClusterArn: aws.String(arn),
+			})
+			if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+				return out, "", nil
+			}
+			if err != nil {
+				return out, "", err
+			}
+
+			return out, aws.StringValue(out.ClusterInfo.State), nil
+		},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceAwsMskClusterName(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("cluster_name"); ok {
+		return v.(string)
+	}
+
+	var prefix string
+	if v, ok := d.GetOk("cluster_name_prefix"); ok {
+		prefix = v.(string)
+	} else {
+		prefix = resource.UniqueIdPrefix
+	}
+
+	return resource.PrefixedUniqueId(prefix)
+}
+
+func sortMskClusterEndpoints(s string) string {
+	splitBootstrapBrokers := strings.Split(s, ",")
+	sort.Strings(splitBootstrapBrokers)
+	return strings.Join(splitBootstrapBrokers, ",")
+}
+
+func expandMskClusterBrokerNodeGroupInfo(in []interface{}) *kafka.BrokerNodeGroupInfo {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	info := &kafka.BrokerNodeGroupInfo{
+		BrokerAZDistribution: aws.String(m["az_distribution"].(string)),
+		ClientSubnets:        expandStringList(m["client_subnets"].([]interface{})),
+		InstanceType:         aws.String(m["instance_type"].(string)),
+		SecurityGroups:       expandStringList(m["security_groups"].([]interface{})),
+	}
+
+	if v, ok := m["ebs_volume_size"].(int); ok && v != 0 {
+		info.StorageInfo = &kafka.StorageInfo{
+			EbsStorageInfo: &kafka.EBSStorageInfo{
+				VolumeSize: aws.Int64(int64(v)),
+			},
+		}
+	}
+
+	if pt := expandMskClusterProvisionedThroughput(m["ebs_storage_info"].([]interface{})); pt != nil {
+		if info.StorageInfo == nil {
+			info.StorageInfo = &kafka.StorageInfo{EbsStorageInfo: &kafka.EBSStorageInfo{}}
+		}
+		info.StorageInfo.EbsStorageInfo.ProvisionedThroughput = pt
+	}
+
+	return info
+}
+
+func expandMskClusterProvisionedThroughput(ebsStorageInfo []interface{}) *kafka.ProvisionedThroughput {
+	if len(ebsStorageInfo) == 0 || ebsStorageInfo[0] == nil {
+		return nil
+	}
+	storage := ebsStorageInfo[0].(map[string]interface{})
+
+	ptList, ok := storage["provisioned_throughput"].([]interface{})
+	if !ok || len(ptList) == 0 || ptList[0] == nil {
+		return nil
+	}
+	pt := ptList[0].(map[string]interface{})
+
+	return &kafka.ProvisionedThroughput{
+		Enabled:          aws.Bool(pt["enabled"].(bool)),
+		VolumeThroughput: aws.Int64(int64(pt["volume_throughput"].(int))),
+	}
+}
+
+func flattenMskClusterBrokerNodeGroupInfo(in *kafka.BrokerNodeGroupInfo, ebsStorageInfo []map[string]interface{}) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"az_distribution": aws.StringValue(in.BrokerAZDistribution),
+		"client_subnets":  aws.StringValueSlice(in.ClientSubnets),
+		"instance_type":   aws.StringValue(in.InstanceType),
+		"security_groups": aws.StringValueSlice(in.SecurityGroups),
+	}
+
+	storageInfo := map[string]interface{}{}
+	if len(ebsStorageInfo) > 0 {
+		storageInfo = ebsStorageInfo[0]
+	}
+
+	if in.StorageInfo != nil && in.StorageInfo.EbsStorageInfo != nil {
+		m["ebs_volume_size"] = aws.Int64Value(in.StorageInfo.EbsStorageInfo.VolumeSize)
+
+		if pt := in.StorageInfo.EbsStorageInfo.ProvisionedThroughput; pt != nil {
+			storageInfo["provisioned_throughput"] = []map[string]interface{}{
+				{
+					"enabled":           aws.BoolValue(pt.Enabled),
+					"volume_throughput": aws.Int64Value(pt.VolumeThroughput),
+				},
+			}
+		}
+	}
+
+	if len(storageInfo) > 0 {
+		m["ebs_storage_info"] = []map[string]interface{}{storageInfo}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+const mskClusterBrokerStorageScalableDimension = "kafka:broker-storage:VolumeSize"
+
+func mskClusterAppAutoScalingResourceID(clusterArn string) (string, error) {
+	parsedArn, err := arn.Parse(clusterArn)
+	if err != nil {
+		return "", fmt.Errorf("error parsing MSK Cluster ARN (%s): %w", clusterArn, err)
+	}
+
+	return parsedArn.Resource, nil
+}
+
+// resourceAwsMskClusterSetBrokerStorageAutoScaling registers (or updates) the
+// Application Auto Scaling scalable target and target-tracking policy backing
+// broker_node_group_info.0.ebs_storage_info.0.volume_size_autoscaling, so that
+// users don't need a separate aws_appautoscaling_target/policy pair.
+func resourceAwsMskClusterSetBrokerStorageAutoScaling(d *schema.ResourceData, meta interface{}) error {
+	autoScaling := expandMskClusterBrokerStorageAutoScaling(d.Get("broker_node_group_info").([]interface{}))
+	if autoScaling == nil {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).appautoscalingconn
+
+	resourceID, err := mskClusterAppAutoScalingResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	currentVolumeSize := int64(d.Get("broker_node_group_info.0.ebs_volume_size").(int))
+
+	_, err = conn.RegisterScalableTarget(&applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceKafka),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(mskClusterBrokerStorageScalableDimension),
+		MinCapacity:       aws.Int64(currentVolumeSize),
+		MaxCapacity:       aws.Int64(aws.Int64Value(autoScaling.MaxVolumeSizeInGiB)),
+	})
+	if err != nil {
+		return fmt.Errorf("error registering MSK Cluster (%s) broker storage scalable target: %w", d.Id(), err)
+	}
+
+	_, err = conn.PutScalingPolicy(&applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:        aws.String(fmt.Sprintf("%s-broker-storage", resourceID)),
+		PolicyType:        aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceKafka),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(mskClusterBrokerStorageScalableDimension),
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String("KafkaBrokerStorageUtilization"),
+			},
+			TargetValue:     aws.Float64(float64(aws.Int64Value(autoScaling.TargetTrackingUtilizationPercentage))),
+			ScaleInCooldown: aws.Int64(aws.Int64Value(autoScaling.ScaleInCooldown)),
+			DisableScaleIn:  aws.Bool(false),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error putting MSK Cluster (%s) broker storage scaling policy: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMskClusterDeregisterBrokerStorageAutoScaling(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appautoscalingconn
+
+	resourceID, err := mskClusterAppAutoScalingResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeregisterScalableTarget(&applicationautoscaling.DeregisterScalableTargetInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceKafka),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(mskClusterBrokerStorageScalableDimension),
+	})
+	if isAWSErr(err, applicationautoscaling.ErrCodeObjectNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deregistering MSK Cluster (%s) broker storage scalable target: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenMskClusterBrokerStorageAutoScaling(d *schema.ResourceData, meta interface{}) ([]map[string]interface{}, error) {
+	conn := meta.(*AWSClient).appautoscalingconn
+
+	resourceID, err := mskClusterAppAutoScalingResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := conn.DescribeScalableTargets(&applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceKafka),
+		ResourceIds:       []*string{aws.String(resourceID)},
+		ScalableDimension: aws.String(mskClusterBrokerStorageScalableDimension),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing MSK Cluster (%s) broker storage scalable targets: %w", d.Id(), err)
+	}
+
+	if len(targets.ScalableTargets) == 0 {
+		return nil, nil
+	}
+
+	policies, err := conn.DescribeScalingPolicies(&applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceKafka),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(mskClusterBrokerStorageScalableDimension),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing MSK Cluster (%s) broker storage scaling policies: %w", d.Id(), err)
+	}
+
+	if len(policies.ScalingPolicies) == 0 {
+		return nil, nil
+	}
+
+	policy := policies.ScalingPolicies[0]
+	target := targets.ScalableTargets[0]
+
+	autoScaling := map[string]interface{}{
+		"max_volume_size_in_gib": aws.Int64Value(target.MaxCapacity),
+		"scale_in_cooldown":      0,
+	}
+
+	if ttConfig := policy.TargetTrackingScalingPolicyConfiguration; ttConfig != nil {
+		autoScaling["target_tracking_utilization_percentage"] = int(aws.Float64Value(ttConfig.TargetValue))
+		autoScaling["scale_in_cooldown"] = aws.Int64Value(ttConfig.ScaleInCooldown)
+	}
+
+	return []map[string]interface{}{
+		{
+			"volume_size_autoscaling": []map[string]interface{}{autoScaling},
+		},
+	}, nil
+}
+
+type mskClusterBrokerStorageAutoScalingConfig struct {
+	TargetTrackingUtilizationPercentage *int64
+	MaxVolumeSizeInGiB                  *int64
+	ScaleInCooldown                     *int64
+}
+
+func expandMskClusterBrokerStorageAutoScaling(in []interface{}) *mskClusterBrokerStorageAutoScalingConfig {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	ebsStorageInfo, ok := m["ebs_storage_info"].([]interface{})
+	if !ok || len(ebsStorageInfo) == 0 || ebsStorageInfo[0] == nil {
+		return nil
+	}
+	storage := ebsStorageInfo[0].(map[string]interface{})
+
+	autoScalingList, ok := storage["volume_size_autoscaling"].([]interface{})
+	if !ok || len(autoScalingList) == 0 || autoScalingList[0] == nil {
+		return nil
+	}
+	autoScaling := autoScalingList[0].(map[string]interface{})
+
+	return &mskClusterBrokerStorageAutoScalingConfig{
+		TargetTrackingUtilizationPercentage: aws.Int64(int64(autoScaling["target_tracking_utilization_percentage"].(int))),
+		MaxVolumeSizeInGiB:                  aws.Int64(int64(autoScaling["max_volume_size_in_gib"].(int))),
+		ScaleInCooldown:                     aws.Int64(int64(autoScaling["scale_in_cooldown"].(int))),
+	}
+}
+
+func expandMskClusterClientAuthentication(in []interface{}) *kafka.ClientAuthentication {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	ca := &kafka.ClientAuthentication{}
+
+	if v, ok := m["sasl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sasl := v[0].(map[string]interface{})
+		ca.Sasl = &kafka.Sasl{
+			Iam: &kafka.Iam{
+				Enabled: aws.Bool(sasl["iam"].(bool)),
+			},
+			Scram: &kafka.Scram{
+				Enabled: aws.Bool(sasl["scram"].(bool)),
+			},
+		}
+	}
+
+	if v, ok := m["tls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tls := v[0].(map[string]interface{})
+		ca.Tls = &kafka.Tls{
+			CertificateAuthorityArnList: expandStringList(tls["certificate_authority_arns"].([]interface{})),
+		}
+	}
+
+	return ca
+}
+
+func flattenMskClusterClientAuthentication(in *kafka.ClientAuthentication) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if in.Sasl != nil {
+		sasl := map[string]interface{}{}
+		if in.Sasl.Iam != nil {
+			sasl["iam"] = aws.BoolValue(in.Sasl.Iam.Enabled)
+		}
+		if in.Sasl.Scram != nil {
+			sasl["scram"] = aws.BoolValue(in.Sasl.Scram.Enabled)
+		}
+		if len(sasl) > 0 {
+			m["sasl"] = []map[string]interface{}{sasl}
+		}
+	}
+
+	if in.Tls != nil {
+		m["tls"] = []map[string]interface{}{
+			{
+				"certificate_authority_arns": aws.StringValueSlice(in.Tls.CertificateAuthorityArnList),
+			},
+		}
+	}
+
+	if len(m) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandMskClusterConfigurationInfo(in []interface{}) *kafka.ConfigurationInfo {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	return &kafka.ConfigurationInfo{
+		Arn:      aws.String(m["arn"].(string)),
+		Revision: aws.Int64(int64(m["revision"].(int))),
+	}
+}
+
+func flattenMskClusterConfigurationInfo(in *kafka.BrokerSoftwareInfo) []map[string]interface{} {
+	if in == nil || in.ConfigurationArn == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"arn":      aws.StringValue(in.ConfigurationArn),
+			"revision": aws.Int64Value(in.ConfigurationRevision),
+		},
+	}
+}
+
+func expandMskClusterEncryptionInfo(in []interface{}) *kafka.EncryptionInfo {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	info := &kafka.EncryptionInfo{}
+
+	if v, ok := m["encryption_at_rest_kms_key_arn"].(string); ok && v != "" {
+		info.EncryptionAtRest = &kafka.EncryptionAtRest{
+			DataVolumeKMSKeyId: aws.String(v),
+		}
+	}
+
+	if v, ok := m["encryption_in_transit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		transit := v[0].(map[string]interface{})
+		info.EncryptionInTransit = &kafka.EncryptionInTransit{
+			ClientBroker: aws.String(transit["client_broker"].(string)),
+			InCluster:    aws.Bool(transit["in_cluster"].(bool)),
+		}
+	}
+
+	return info
+}
+
+func flattenMskClusterEncryptionInfo(in *kafka.EncryptionInfo) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if in.EncryptionAtRest != nil {
+		m["encryption_at_rest_kms_key_arn"] = aws.StringValue(in.EncryptionAtRest.DataVolumeKMSKeyId)
+	}
+
+	if in.EncryptionInTransit != nil {
+		m["encryption_in_transit"] = []map[string]interface{}{
+			{
+				"client_broker": aws.StringValue(in.EncryptionInTransit.ClientBroker),
+				"in_cluster":    aws.BoolValue(in.EncryptionInTransit.InCluster),
+			},
+		}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+// validateMskClusterLoggingInfo rejects plans where a logging_info destination
+// is enabled but missing the identifier MSK needs to deliver to it (log group,
+// delivery stream, or bucket), instead of failing at apply time against the API.
+func validateMskClusterLoggingInfo(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	loggingInfo := diff.Get("logging_info").([]interface{})
+	if len(loggingInfo) == 0 || loggingInfo[0] == nil {
+		return nil
+	}
+
+	brokerLogsList := loggingInfo[0].(map[string]interface{})["broker_logs"].([]interface{})
+	if len(brokerLogsList) == 0 || brokerLogsList[0] == nil {
+		return nil
+	}
+	brokerLogs := brokerLogsList[0].(map[string]interface{})
+
+	if v, ok := brokerLogs["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cw := v[0].(map[string]interface{})
+		if cw["enabled"].(bool) && cw["log_group"].(string) == "" {
+			return fmt.Errorf("logging_info.0.broker_logs.0.cloudwatch_logs.0.log_group is required when cloudwatch_logs is enabled")
+		}
+	}
+
+	if v, ok := brokerLogs["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fh := v[0].(map[string]interface{})
+		if fh["enabled"].(bool) && fh["delivery_stream"].(string) == "" {
+			return fmt.Errorf("logging_info.0.broker_logs.0.firehose.0.delivery_stream is required when firehose is enabled")
+		}
+	}
+
+	if v, ok := brokerLogs["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s3 := v[0].(map[string]interface{})
+		if s3["enabled"].(bool) && s3["bucket"].(string) == "" {
+			return fmt.Errorf("logging_info.0.broker_logs.0.s3.0.bucket is required when s3 is enabled")
+		}
+	}
+
+	return nil
+}
+
+// validateMskClusterClientAuthenticationSaslIam rejects plans that enable
+// AWS_MSK_IAM SASL authentication without TLS client-broker encryption, since
+// the Kafka API rejects IAM auth over a plaintext or TLS_PLAINTEXT listener.
+func validateMskClusterClientAuthenticationSaslIam(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	clientAuthentication := diff.Get("client_authentication").([]interface{})
+	if len(clientAuthentication) == 0 || clientAuthentication[0] == nil {
+		return nil
+	}
+
+	saslList, ok := clientAuthentication[0].(map[string]interface{})["sasl"].([]interface{})
+	if !ok || len(saslList) == 0 || saslList[0] == nil {
+		return nil
+	}
+
+	if !saslList[0].(map[string]interface{})["iam"].(bool) {
+		return nil
+	}
+
+	clientBroker := kafka.ClientBrokerTls
+	if encryptionInfo := diff.Get("encryption_info").([]interface{}); len(encryptionInfo) > 0 && encryptionInfo[0] != nil {
+		if v, ok := encryptionInfo[0].(map[string]interface{})["encryption_in_transit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			clientBroker = v[0].(map[string]interface{})["client_broker"].(string)
+		}
+	}
+
+	if clientBroker != kafka.ClientBrokerTls {
+		return fmt.Errorf("encryption_info.0.encryption_in_transit.0.client_broker must be %q when client_authentication.0.sasl.0.iam is enabled", kafka.ClientBrokerTls)
+	}
+
+	return nil
+}
+
+// validateMskClusterEncryptionInTransitPlaintext rejects plans that leave
+// brokers reachable over a plaintext listener (PLAINTEXT or TLS_PLAINTEXT)
+// unless the caller has explicitly opted in via allow_plaintext_in_transit,
+// since client_broker otherwise defaults to TLS-only.
+func validateMskClusterEncryptionInTransitPlaintext(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("allow_plaintext_in_transit").(bool) {
+		return nil
+	}
+
+	clientBroker := kafka.ClientBrokerTls
+	if encryptionInfo := diff.Get("encryption_info").([]interface{}); len(encryptionInfo) > 0 && encryptionInfo[0] != nil {
+		if v, ok := encryptionInfo[0].(map[string]interface{})["encryption_in_transit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			clientBroker = v[0].(map[string]interface{})["client_broker"].(string)
+		}
+	}
+
+	if clientBroker == kafka.ClientBrokerTls {
+		return nil
+	}
+
+	return fmt.Errorf("encryption_info.0.encryption_in_transit.0.client_broker is %q; set allow_plaintext_in_transit = true to allow a plaintext listener", clientBroker)
+}
+
+// mskClusterProvisionedThroughputInstanceTypes are the broker instance types,
+// and larger sizes within the same family, that MSK allows EBS provisioned
+// throughput to be configured on.
+var mskClusterProvisionedThroughputInstanceTypes = map[string]bool{
+	"kafka.m5.4xlarge":  true,
+	"kafka.m5.8xlarge":  true,
+	"kafka.m5.12xlarge": true,
+	"kafka.m5.16xlarge": true,
+	"kafka.m5.24xlarge": true,
+}
+
+// validateMskClusterBrokerNodeGroupInfoProvisionedThroughput rejects plans
+// that enable broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput
+// on an instance type smaller than kafka.m5.4xlarge, which MSK doesn't support.
+func validateMskClusterBrokerNodeGroupInfoProvisionedThroughput(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	brokerNodeGroupInfo := diff.Get("broker_node_group_info").([]interface{})
+	if len(brokerNodeGroupInfo) == 0 || brokerNodeGroupInfo[0] == nil {
+		return nil
+	}
+	m := brokerNodeGroupInfo[0].(map[string]interface{})
+
+	pt := expandMskClusterProvisionedThroughput(m["ebs_storage_info"].([]interface{}))
+	if pt == nil || !aws.BoolValue(pt.Enabled) {
+		return nil
+	}
+
+	if instanceType := m["instance_type"].(string); !mskClusterProvisionedThroughputInstanceTypes[instanceType] {
+		return fmt.Errorf("broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput can only be enabled when broker_node_group_info.0.instance_type is kafka.m5.4xlarge or larger, got: %s", instanceType)
+	}
+
+	return nil
+}
+
+func expandMskClusterLoggingInfo(in []interface{}) *kafka.LoggingInfo {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	brokerLogsList := m["broker_logs"].([]interface{})
+	if len(brokerLogsList) == 0 || brokerLogsList[0] == nil {
+		return nil
+	}
+	brokerLogs := brokerLogsList[0].(map[string]interface{})
+
+	info := &kafka.LoggingInfo{
+		BrokerLogs: &kafka.BrokerLogs{},
+	}
+
+	if v, ok := brokerLogs["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cw := v[0].(map[string]interface{})
+		info.BrokerLogs.CloudWatchLogs = &kafka.CloudWatchLogs{
+			Enabled:  aws.Bool(cw["enabled"].(bool)),
+			LogGroup: aws.String(cw["log_group"].(string)),
+		}
+	}
+
+	if v, ok := brokerLogs["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fh := v[0].(map[string]interface{})
+		info.BrokerLogs.Firehose = &kafka.Firehose{
+			Enabled:        aws.Bool(fh["enabled"].(bool)),
+			DeliveryStream: aws.String(fh["delivery_stream"].(string)),
+		}
+	}
+
+	if v, ok := brokerLogs["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s3 := v[0].(map[string]interface{})
+		info.BrokerLogs.S3 = &kafka.S3{
+			Enabled: aws.Bool(s3["enabled"].(bool)),
+			Bucket:  aws.String(s3["bucket"].(string)),
+			Prefix:  aws.String(s3["prefix"].(string)),
+		}
+	}
+
+	return info
+}
+
+func flattenMskClusterLoggingInfo(in *kafka.LoggingInfo) []map[string]interface{} {
+	if in == nil || in.BrokerLogs == nil {
+		return []map[string]interface{}{}
+	}
+
+	brokerLogs := map[string]interface{}{}
+
+	if cw := in.BrokerLogs.CloudWatchLogs; cw != nil {
+		brokerLogs["cloudwatch_logs"] = []map[string]interface{}{
+			{
+				"enabled":   aws.BoolValue(cw.Enabled),
+				"log_group": aws.StringValue(cw.LogGroup),
+			},
+		}
+	}
+
+	if fh := in.BrokerLogs.Firehose; fh != nil {
+		brokerLogs["firehose"] = []map[string]interface{}{
+			{
+				"enabled":         aws.BoolValue(fh.Enabled),
+				"delivery_stream": aws.StringValue(fh.DeliveryStream),
+			},
+		}
+	}
+
+	if s3 := in.BrokerLogs.S3; s3 != nil {
+		brokerLogs["s3"] = []map[string]interface{}{
+			{
+				"enabled": aws.BoolValue(s3.Enabled),
+				"bucket":  aws.StringValue(s3.Bucket),
+				"prefix":  aws.StringValue(s3.Prefix),
+			},
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"broker_logs": []map[string]interface{}{brokerLogs},
+		},
+	}
+}
+
+func expandMskClusterOpenMonitoring(in []interface{}) *kafka.OpenMonitoringInfo {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	prometheusList := m["prometheus"].([]interface{})
+	if len(prometheusList) == 0 || prometheusList[0] == nil {
+		return nil
+	}
+	prometheus := prometheusList[0].(map[string]interface{})
+
+	info := &kafka.OpenMonitoringInfo{
+		Prometheus: &kafka.PrometheusInfo{},
+	}
+
+	if v, ok := prometheus["jmx_exporter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		jmx := v[0].(map[string]interface{})
+		info.Prometheus.JmxExporter = &kafka.JmxExporterInfo{
+			EnabledInBroker: aws.Bool(jmx["enabled_in_broker"].(bool)),
+		}
+	}
+
+	if v, ok := prometheus["node_exporter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		node := v[0].(map[string]interface{})
+		info.Prometheus.NodeExporter = &kafka.NodeExporterInfo{
+			EnabledInBroker: aws.Bool(node["enabled_in_broker"].(bool)),
+		}
+	}
+
+	return info
+}
+
+func flattenMskClusterOpenMonitoring(in *kafka.OpenMonitoringInfo) []map[string]interface{} {
+	if in == nil || in.Prometheus == nil {
+		return []map[string]interface{}{}
+	}
+
+	prometheus := map[string]interface{}{}
+
+	if jmx := in.Prometheus.JmxExporter; jmx != nil {
+		prometheus["jmx_exporter"] = []map[string]interface{}{
+			{"enabled_in_broker": aws.BoolValue(jmx.EnabledInBroker)},
+		}
+	}
+
+	if node := in.Prometheus.NodeExporter; node != nil {
+		prometheus["node_exporter"] = []map[string]interface{}{
+			{"enabled_in_broker": aws.BoolValue(node.EnabledInBroker)},
+		}
+	}
+
+	return []map[string]interface{}{
+		{"prometheus": []map[string]interface{}{prometheus}},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -58,6 +59,7 @@ func testSweepMskClusters(region string) error {
 const (
 	mskClusterPortPlaintext = 9092
 	mskClusterPortSasl      = 9096
+	mskClusterPortSaslIam   = 9098
 	mskClusterPortTls       = 9094
 
 	mskClusterPortZookeeper = 2181
@@ -68,9 +70,10 @@ const (
 )
 
 var (
-	mskClusterBoostrapBrokersRegexp     = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortPlaintext))
-	mskClusterBoostrapBrokersSaslRegexp = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortSasl))
-	mskClusterBoostrapBrokersTlsRegexp  = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortTls))
+	mskClusterBoostrapBrokersRegexp        = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortPlaintext))
+	mskClusterBoostrapBrokersSaslRegexp    = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortSasl))
+	mskClusterBoostrapBrokersSaslIamRegexp = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortSaslIam))
+	mskClusterBoostrapBrokersTlsRegexp     = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortTls))
 
 	mskClusterZookeeperConnectStringRegexp = regexp.MustCompile(fmt.Sprintf(mskClusterBrokerRegexpFormat, mskClusterPortZookeeper))
 )
@@ -134,6 +137,36 @@ func TestAccAWSMskCluster_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSMskCluster_NamePrefix(t *testing.T) {
+	var cluster kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfigNamePrefix(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster),
+					resource.TestMatchResourceAttr(resourceName, "cluster_name", regexp.MustCompile(fmt.Sprintf("^%s", rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"cluster_name_prefix",
+					"current_version",
+				},
+			},
+		},
+	})
+}
+
 func TestAccAWSMskCluster_BrokerNodeGroupInfo_EbsVolumeSize(t *testing.T) {
 	var cluster1, cluster2 kafka.ClusterInfo
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -171,6 +204,220 @@ func TestAccAWSMskCluster_BrokerNodeGroupInfo_EbsVolumeSize(t *testing.T) {
 	})
 }
 
+func TestAccAWSMskCluster_BrokerNodeGroupInfo_EbsStorageInfo_VolumeSizeAutoScaling(t *testing.T) {
+	var cluster1, cluster2 kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckMskClusterDestroy,
+			testAccCheckMskClusterBrokerStorageAutoScalingRemoved,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfigBrokerNodeGroupInfoEbsVolumeSize(rName, 11),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.#", "0"),
+				),
+			},
+			{
+				Config: testAccMskClusterConfigBrokerNodeGroupInfoEbsStorageInfoVolumeSizeAutoScaling(rName, 11, 80, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster2),
+					testAccCheckMskClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.volume_size_autoscaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.volume_size_autoscaling.0.target_tracking_utilization_percentage", "80"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.volume_size_autoscaling.0.max_volume_size_in_gib", "200"),
+					testAccCheckMskClusterBrokerStorageAutoScalingExists(resourceName),
+				),
+			},
+			{
+				Config: testAccMskClusterConfigBrokerNodeGroupInfoEbsStorageInfoVolumeSizeAutoScaling(rName, 11, 80, 400),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster2),
+					testAccCheckMskClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.volume_size_autoscaling.0.max_volume_size_in_gib", "400"),
+				),
+			},
+			{
+				// Re-applying the same autoscaling config must produce an empty plan.
+				Config:   testAccMskClusterConfigBrokerNodeGroupInfoEbsStorageInfoVolumeSizeAutoScaling(rName, 11, 80, 400),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMskCluster_BrokerNodeGroupInfo_EbsStorageInfo_ProvisionedThroughput(t *testing.T) {
+	var cluster1, cluster2, cluster3 kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfigEbsThroughput(rName, true, 250),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput.0.volume_throughput", "250"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMskClusterConfigEbsThroughput(rName, true, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster2),
+					testAccCheckMskClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput.0.volume_throughput", "500"),
+				),
+			},
+			{
+				Config: testAccMskClusterConfigEbsThroughput(rName, false, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster3),
+					testAccCheckMskClusterNotRecreated(&cluster2, &cluster3),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_storage_info.0.provisioned_throughput.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMskCluster_BrokerNodeGroupInfo_EbsStorageInfo_ProvisionedThroughput_RequiresLargeInstance(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMskClusterConfigEbsThroughputSmallInstance(rName),
+				ExpectError: regexp.MustCompile(`can only be enabled when`),
+			},
+		},
+	})
+}
+
+func testAccMskClusterConfigEbsThroughput(rName string, enabled bool, volumeThroughput int) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.2.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 100
+    instance_type   = "kafka.m5.4xlarge"
+    security_groups = [aws_security_group.example_sg.id]
+
+    ebs_storage_info {
+      provisioned_throughput {
+        enabled           = %[2]t
+        volume_throughput = %[3]d
+      }
+    }
+  }
+}
+`, rName, enabled, volumeThroughput)
+}
+
+func testAccMskClusterConfigEbsThroughputSmallInstance(rName string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.2.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 100
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+
+    ebs_storage_info {
+      provisioned_throughput {
+        enabled           = true
+        volume_throughput = 250
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCheckMskClusterBrokerStorageAutoScalingRemoved(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_cluster" {
+			continue
+		}
+
+		resourceID, err := mskClusterAppAutoScalingResourceID(rs.Primary.ID)
+		if err != nil {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+		out, err := conn.DescribeScalableTargets(&applicationautoscaling.DescribeScalableTargetsInput{
+			ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceKafka),
+			ResourceIds:       []*string{aws.String(resourceID)},
+			ScalableDimension: aws.String(mskClusterBrokerStorageScalableDimension),
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.ScalableTargets) > 0 {
+			return fmt.Errorf("MSK Cluster (%s) broker storage scalable target still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMskClusterBrokerStorageAutoScalingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceID, err := mskClusterAppAutoScalingResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+		out, err := conn.DescribeScalableTargets(&applicationautoscaling.DescribeScalableTargetsInput{
+			ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceKafka),
+			ResourceIds:       []*string{aws.String(resourceID)},
+			ScalableDimension: aws.String(mskClusterBrokerStorageScalableDimension),
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.ScalableTargets) == 0 {
+			return fmt.Errorf("MSK Cluster (%s) broker storage scalable target not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func TestAccAWSMskCluster_ClientAuthentication_Sasl_Scram(t *testing.T) {
 	var cluster1, cluster2 kafka.ClusterInfo
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -229,6 +476,56 @@ func TestAccAWSMskCluster_ClientAuthentication_Sasl_Scram(t *testing.T) {
 	})
 }
 
+func TestAccAWSMskCluster_ClientAuthentication_Sasl_Iam(t *testing.T) {
+	var cluster1, cluster2 kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfigClientAuthenticationSaslIam(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "client_authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_authentication.0.sasl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_authentication.0.sasl.0.iam", "true"),
+
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_brokers", ""),
+					resource.TestMatchResourceAttr(resourceName, "bootstrap_brokers_sasl_iam", mskClusterBoostrapBrokersSaslIamRegexp),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_brokers_sasl_scram", ""),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_brokers_tls", ""),
+
+					testCheckResourceAttrIsSortedCsv(resourceName, "bootstrap_brokers_sasl_iam"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"current_version",
+				},
+			},
+			{
+				Config: testAccMskClusterConfigClientAuthenticationSaslIam(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster2),
+					testAccCheckMskClusterRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "client_authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_authentication.0.sasl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_authentication.0.sasl.0.iam", "false"),
+
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_brokers_sasl_iam", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSMskCluster_ClientAuthentication_Tls_CertificateAuthorityArns(t *testing.T) {
 	TestAccSkip(t, "Requires the aws_acmpca_certificate_authority resource to support importing the root CA certificate")
 
@@ -363,6 +660,49 @@ func TestAccAWSMskCluster_EncryptionInfo_EncryptionInTransit_ClientBroker(t *tes
 	})
 }
 
+func TestAccAWSMskCluster_EncryptionInfo_EncryptionInTransit_RequiresPlaintextOptIn(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMskClusterConfigEncryptionInfoEncryptionInTransitClientBrokerNoOptIn(rName, "TLS_PLAINTEXT"),
+				ExpectError: regexp.MustCompile(`allow_plaintext_in_transit`),
+			},
+			{
+				Config:      testAccMskClusterConfigEncryptionInfoEncryptionInTransitClientBrokerNoOptIn(rName, "PLAINTEXT"),
+				ExpectError: regexp.MustCompile(`allow_plaintext_in_transit`),
+			},
+		},
+	})
+}
+
+func testAccMskClusterConfigEncryptionInfoEncryptionInTransitClientBrokerNoOptIn(rName, clientBroker string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.2.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = %[2]q
+    }
+  }
+}
+`, rName, clientBroker)
+}
+
 func TestAccAWSMskCluster_EncryptionInfo_EncryptionInTransit_InCluster(t *testing.T) {
 	var cluster1 kafka.ClusterInfo
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -529,6 +869,47 @@ func TestAccAWSMskCluster_OpenMonitoring(t *testing.T) {
 	})
 }
 
+func TestAccAWSMskCluster_LoggingInfo_RequiresDestinationWhenEnabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMskClusterConfigLoggingInfoCloudWatchEnabledNoLogGroup(rName),
+				ExpectError: regexp.MustCompile(`log_group is required`),
+			},
+		},
+	})
+}
+
+func testAccMskClusterConfigLoggingInfoCloudWatchEnabledNoLogGroup(rName string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.2.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  logging_info {
+    broker_logs {
+      cloudwatch_logs {
+        enabled = true
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func TestAccAWSMskCluster_LoggingInfo(t *testing.T) {
 	var cluster1, cluster2 kafka.ClusterInfo
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -588,7 +969,7 @@ func TestAccAWSMskCluster_KafkaVersionUpgrade(t *testing.T) {
 		CheckDestroy: testAccCheckMskClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMskClusterConfigKafkaVersion(rName, "2.2.1"),
+				Config: testAccMskClusterConfigKafkaVersionTLSPlaintext(rName, "2.2.1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMskClusterExists(resourceName, &cluster1),
 					resource.TestCheckResourceAttr(resourceName, "kafka_version", "2.2.1"),
@@ -600,7 +981,18 @@ func TestAccAWSMskCluster_KafkaVersionUpgrade(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccMskClusterConfigKafkaVersion(rName, "2.4.1.1"),
+				Config: testAccMskClusterConfigKafkaVersionTLSPlaintext(rName, "2.4.1.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster2),
+					testAccCheckMskClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "kafka_version", "2.4.1.1"),
+				),
+			},
+			{
+				// Ensures a custom timeouts block is honored rather than the
+				// hardcoded 120m default, since Kafka version upgrades on large
+				// clusters can run well past that.
+				Config: testAccMskClusterConfigKafkaVersionTLSPlaintextWithTimeouts(rName, "2.4.1.1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMskClusterExists(resourceName, &cluster2),
 					testAccCheckMskClusterNotRecreated(&cluster1, &cluster2),
@@ -622,7 +1014,7 @@ func TestAccAWSMskCluster_KafkaVersionDowngrade(t *testing.T) {
 		CheckDestroy: testAccCheckMskClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMskClusterConfigKafkaVersion(rName, "2.4.1.1"),
+				Config: testAccMskClusterConfigKafkaVersionTLSPlaintext(rName, "2.4.1.1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMskClusterExists(resourceName, &cluster1),
 					resource.TestCheckResourceAttr(resourceName, "kafka_version", "2.4.1.1"),
@@ -641,7 +1033,7 @@ func TestAccAWSMskCluster_KafkaVersionDowngrade(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccMskClusterConfigKafkaVersion(rName, "2.2.1"),
+				Config: testAccMskClusterConfigKafkaVersionTLSPlaintext(rName, "2.2.1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMskClusterExists(resourceName, &cluster2),
 					testAccCheckMskClusterRecreated(&cluster1, &cluster2),
@@ -672,7 +1064,7 @@ func TestAccAWSMskCluster_KafkaVersionUpgradeWithConfigurationInfo(t *testing.T)
 		CheckDestroy: testAccCheckMskClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMskClusterConfigKafkaVersionWithConfigurationInfo(rName, "2.2.1", "config1"),
+				Config: testAccMskClusterConfigKafkaVersionTLSPlaintextWithConfigurationInfo(rName, "2.2.1", "config1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMskClusterExists(resourceName, &cluster1),
 					resource.TestCheckResourceAttr(resourceName, "kafka_version", "2.2.1"),
@@ -687,7 +1079,7 @@ func TestAccAWSMskCluster_KafkaVersionUpgradeWithConfigurationInfo(t *testing.T)
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccMskClusterConfigKafkaVersionWithConfigurationInfo(rName, "2.4.1.1", "config2"),
+				Config: testAccMskClusterConfigKafkaVersionTLSPlaintextWithConfigurationInfo(rName, "2.4.1.1", "config2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMskClusterExists(resourceName, &cluster2),
 					testAccCheckMskClusterNotRecreated(&cluster1, &cluster2),
@@ -928,6 +1320,23 @@ resource "aws_msk_cluster" "test" {
 `, rName)
 }
 
+func testAccMskClusterConfigNamePrefix(rName string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name_prefix    = %[1]q
+  kafka_version          = "2.2.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+}
+`, rName)
+}
+
 func testAccMskClusterConfigBrokerNodeGroupInfoEbsVolumeSize(rName string, ebsVolumeSize int) string {
 	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
 resource "aws_msk_cluster" "test" {
@@ -945,6 +1354,30 @@ resource "aws_msk_cluster" "test" {
 `, rName, ebsVolumeSize)
 }
 
+func testAccMskClusterConfigBrokerNodeGroupInfoEbsStorageInfoVolumeSizeAutoScaling(rName string, ebsVolumeSize, targetTrackingUtilizationPercentage, maxVolumeSizeInGiB int) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.2.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = %[2]d
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+
+    ebs_storage_info {
+      volume_size_autoscaling {
+        target_tracking_utilization_percentage = %[3]d
+        max_volume_size_in_gib                 = %[4]d
+      }
+    }
+  }
+}
+`, rName, ebsVolumeSize, targetTrackingUtilizationPercentage, maxVolumeSizeInGiB)
+}
+
 func testAccMskClusterConfigClientAuthenticationTlsCertificateAuthorityArns(rName string) string {
 	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
 resource "aws_acmpca_certificate_authority" "test" {
@@ -1008,6 +1441,74 @@ resource "aws_msk_cluster" "test" {
 `, rName, enabled)
 }
 
+func TestAccAWSMskCluster_ClientAuthentication_Sasl_Iam_RequiresTls(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMskClusterConfigClientAuthenticationSaslIamPlaintext(rName),
+				ExpectError: regexp.MustCompile(`client_broker must be "TLS"`),
+			},
+		},
+	})
+}
+
+func testAccMskClusterConfigClientAuthenticationSaslIamPlaintext(rName string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = "TLS_PLAINTEXT"
+    }
+  }
+
+  client_authentication {
+    sasl {
+      iam = true
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMskClusterConfigClientAuthenticationSaslIam(rName string, enabled bool) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  client_authentication {
+    sasl {
+      iam = %t
+    }
+  }
+}
+`, rName, enabled)
+}
+
 func testAccMskClusterConfigConfigurationInfoRevision1(rName string) string {
 	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
 resource "aws_msk_configuration" "test" {
@@ -1112,9 +1613,10 @@ resource "aws_msk_cluster" "test" {
 func testAccMskClusterConfigEncryptionInfoEncryptionInTransitClientBroker(rName, clientBroker string) string {
 	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
 resource "aws_msk_cluster" "test" {
-  cluster_name           = %[1]q
-  kafka_version          = "2.2.1"
-  number_of_broker_nodes = 3
+  cluster_name               = %[1]q
+  kafka_version              = "2.2.1"
+  number_of_broker_nodes     = 3
+  allow_plaintext_in_transit = true
 
   broker_node_group_info {
     client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
@@ -1323,12 +1825,37 @@ resource "aws_msk_cluster" "test" {
 `, rName, cloudwatchLogsEnabled, cloudwatchLogsLogGroup, firehoseEnabled, firehoseDeliveryStream, s3Enabled, s3Bucket)
 }
 
-func testAccMskClusterConfigKafkaVersion(rName string, kafkaVersion string) string {
+func testAccMskClusterConfigKafkaVersionTLSPlaintext(rName string, kafkaVersion string) string {
 	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
 resource "aws_msk_cluster" "test" {
-  cluster_name           = %[1]q
-  kafka_version          = %[2]q
-  number_of_broker_nodes = 3
+  cluster_name               = %[1]q
+  kafka_version              = %[2]q
+  number_of_broker_nodes     = 3
+  allow_plaintext_in_transit = true
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = "TLS_PLAINTEXT"
+    }
+  }
+
+  broker_node_group_info {
+    client_subnets  = ["${aws_subnet.example_subnet_az1.id}", "${aws_subnet.example_subnet_az2.id}", "${aws_subnet.example_subnet_az3.id}"]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = ["${aws_security_group.example_sg.id}"]
+  }
+}
+`, rName, kafkaVersion)
+}
+
+func testAccMskClusterConfigKafkaVersionTLSPlaintextWithTimeouts(rName string, kafkaVersion string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name               = %[1]q
+  kafka_version              = %[2]q
+  number_of_broker_nodes     = 3
+  allow_plaintext_in_transit = true
 
   encryption_info {
     encryption_in_transit {
@@ -1342,11 +1869,17 @@ resource "aws_msk_cluster" "test" {
     instance_type   = "kafka.m5.large"
     security_groups = ["${aws_security_group.example_sg.id}"]
   }
+
+  timeouts {
+    create = "180m"
+    update = "180m"
+    delete = "180m"
+  }
 }
 `, rName, kafkaVersion)
 }
 
-func testAccMskClusterConfigKafkaVersionWithConfigurationInfo(rName string, kafkaVersion string, configResourceName string) string {
+func testAccMskClusterConfigKafkaVersionTLSPlaintextWithConfigurationInfo(rName string, kafkaVersion string, configResourceName string) string {
 	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
 resource "aws_msk_configuration" "config1" {
   kafka_versions    = ["2.2.1"]
@@ -1365,9 +1898,10 @@ PROPERTIES
 }
 
 resource "aws_msk_cluster" "test" {
-  cluster_name           = %[1]q
-  kafka_version          = %[2]q
-  number_of_broker_nodes = 3
+  cluster_name               = %[1]q
+  kafka_version              = %[2]q
+  number_of_broker_nodes     = 3
+  allow_plaintext_in_transit = true
 
   encryption_info {
     encryption_in_transit {
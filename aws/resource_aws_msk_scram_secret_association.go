@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsMskScramSecretAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskScramSecretAssociationCreate,
+		Read:   resourceAwsMskScramSecretAssociationRead,
+		Update: resourceAwsMskScramSecretAssociationUpdate,
+		Delete: resourceAwsMskScramSecretAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"secret_arn_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+			},
+		},
+	}
+}
+
+func resourceAwsMskScramSecretAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	clusterArn := d.Get("cluster_arn").(string)
+	secretArnList := expandStringSet(d.Get("secret_arn_list").(*schema.Set))
+
+	if err := mskScramSecretAssociationBatchAssociate(conn, clusterArn, secretArnList); err != nil {
+		return err
+	}
+
+	d.SetId(clusterArn)
+
+	return resourceAwsMskScramSecretAssociationRead(d, meta)
+}
+
+func resourceAwsMskScramSecretAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	secretArnList, err := mskScramSecretAssociationListSecrets(conn, d.Id())
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MSK Cluster (%s) not found, removing SCRAM secret association from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing SCRAM secrets for MSK Cluster (%s): %w", d.Id(), err)
+	}
+
+	if len(secretArnList) == 0 {
+		log.Printf("[WARN] MSK Cluster (%s) has no associated SCRAM secrets, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_arn", d.Id())
+	d.Set("secret_arn_list", aws.StringValueSlice(secretArnList))
+
+	return nil
+}
+
+func resourceAwsMskScramSecretAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	o, n := d.GetChange("secret_arn_list")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	if toAdd := expandStringSet(ns.Difference(os)); len(toAdd) > 0 {
+		if err := mskScramSecretAssociationBatchAssociate(conn, d.Id(), toAdd); err != nil {
+			return err
+		}
+	}
+
+	if toRemove := expandStringSet(os.Difference(ns)); len(toRemove) > 0 {
+		if err := mskScramSecretAssociationBatchDisassociate(conn, d.Id(), toRemove); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMskScramSecretAssociationRead(d, meta)
+}
+
+func resourceAwsMskScramSecretAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	secretArnList := expandStringSet(d.Get("secret_arn_list").(*schema.Set))
+	if len(secretArnList) == 0 {
+		return nil
+	}
+
+	if err := mskScramSecretAssociationBatchDisassociate(conn, d.Id(), secretArnList); err != nil {
+		if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func mskScramSecretAssociationBatchAssociate(conn *kafka.Kafka, clusterArn string, secretArnList []*string) error {
+	log.Printf("[DEBUG] Associating MSK Cluster (%s) SCRAM secrets: %s", clusterArn, secretArnList)
+	output, err := conn.BatchAssociateScramSecret(&kafka.BatchAssociateScramSecretInput{
+		ClusterArn:    aws.String(clusterArn),
+		SecretArnList: secretArnList,
+	})
+	if err != nil {
+		return fmt.Errorf("error associating MSK Cluster (%s) SCRAM secrets: %w", clusterArn, err)
+	}
+
+	return mskScramSecretAssociationUnprocessedError(output.UnprocessedScramSecrets)
+}
+
+func mskScramSecretAssociationBatchDisassociate(conn *kafka.Kafka, clusterArn string, secretArnList []*string) error {
+	log.Printf("[DEBUG] Disassociating MSK Cluster (%s) SCRAM secrets: %s", clusterArn, secretArnList)
+	output, err := conn.BatchDisassociateScramSecret(&kafka.BatchDisassociateScramSecretInput{
+		ClusterArn:    aws.String(clusterArn),
+		SecretArnList: secretArnList,
+	})
+	if err != nil {
+		return fmt.Errorf("error disassociating MSK Cluster (%s) SCRAM secrets: %w", clusterArn, err)
+	}
+
+	return mskScramSecretAssociationUnprocessedError(output.UnprocessedScramSecrets)
+}
+
+func mskScramSecretAssociationUnprocessedError(unprocessed []*kafka.UnprocessedScramSecret) error {
+	if len(unprocessed) == 0 {
+		return nil
+	}
+
+	var errs []string
+	for _, v := range unprocessed {
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(v.SecretArn), aws.StringValue(v.ErrorMessage)))
+	}
+
+	return fmt.Errorf("unprocessed SCRAM secrets: %s", strings.Join(errs, ", "))
+}
+
+func mskScramSecretAssociationListSecrets(conn *kafka.Kafka, clusterArn string) ([]*string, error) {
+	var secretArnList []*string
+
+	input := &kafka.ListScramSecretsInput{
+		ClusterArn: aws.String(clusterArn),
+	}
+
+	err := conn.ListScramSecretsPages(input, func(page *kafka.ListScramSecretsOutput, lastPage bool) bool {
+		secretArnList = append(secretArnList, page.SecretArnList...)
+		return !lastPage
+	})
+
+	return secretArnList, err
+}
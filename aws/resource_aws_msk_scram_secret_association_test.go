@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSMskScramSecretAssociation_basic(t *testing.T) {
+	var cluster kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_scram_secret_association.test"
+	clusterResourceName := "aws_msk_cluster.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskScramSecretAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskScramSecretAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(clusterResourceName, &cluster),
+					testAccCheckMskScramSecretAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", clusterResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "secret_arn_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_arn_list.0", secretResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMskScramSecretAssociationConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskClusterExists(clusterResourceName, &cluster),
+					testAccCheckMskScramSecretAssociationExists(resourceName),
+					testAccCheckMskScramSecretAssociationSecretDisassociated(resourceName, secretResourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", clusterResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "secret_arn_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_arn_list.0", "aws_secretsmanager_secret.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMskScramSecretAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		secretArnList, err := mskScramSecretAssociationListSecrets(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(secretArnList) == 0 {
+			return fmt.Errorf("MSK Cluster (%s) has no associated SCRAM secrets", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckMskScramSecretAssociationSecretDisassociated asserts that the
+// secret identified by secretResourceName is no longer associated with the
+// cluster, proving the resource's disassociate path actually ran.
+func testAccCheckMskScramSecretAssociationSecretDisassociated(n, secretResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretRs, ok := s.RootModule().Resources[secretResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", secretResourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		secretArnList, err := mskScramSecretAssociationListSecrets(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, arn := range secretArnList {
+			if aws.StringValue(arn) == secretRs.Primary.ID {
+				return fmt.Errorf("MSK Cluster (%s) still has SCRAM secret (%s) associated", rs.Primary.ID, secretRs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMskScramSecretAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_scram_secret_association" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		secretArnList, err := mskScramSecretAssociationListSecrets(conn, rs.Primary.ID)
+		if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(secretArnList) > 0 {
+			return fmt.Errorf("MSK Cluster (%s) still has associated SCRAM secrets: %s", rs.Primary.ID, aws.StringValueSlice(secretArnList))
+		}
+	}
+	return nil
+}
+
+func testAccMskScramSecretAssociationConfig_basic(rName string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = %[1]q
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name       = "AmazonMSK_%[1]s"
+  kms_key_id = aws_kms_key.test.key_id
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({ username = "scram-user", password = "ScramSecretPassword123!" })
+}
+
+resource "aws_secretsmanager_secret_policy" "test" {
+  secret_arn = aws_secretsmanager_secret.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowMskAccess"
+      Effect    = "Allow"
+      Principal = { Service = "kafka.amazonaws.com" }
+      Action    = "secretsmanager:GetSecretValue"
+      Resource  = "*"
+    }]
+  })
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = "TLS"
+    }
+  }
+
+  client_authentication {
+    sasl {
+      scram = true
+    }
+  }
+}
+
+resource "aws_msk_scram_secret_association" "test" {
+  cluster_arn     = aws_msk_cluster.test.arn
+  secret_arn_list = [aws_secretsmanager_secret.test.arn]
+
+  depends_on = [aws_secretsmanager_secret_version.test, aws_secretsmanager_secret_policy.test]
+}
+`, rName)
+}
+
+func testAccMskScramSecretAssociationConfig_updated(rName string) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = %[1]q
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name       = "AmazonMSK_%[1]s"
+  kms_key_id = aws_kms_key.test.key_id
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({ username = "scram-user", password = "ScramSecretPassword123!" })
+}
+
+resource "aws_secretsmanager_secret_policy" "test" {
+  secret_arn = aws_secretsmanager_secret.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowMskAccess"
+      Effect    = "Allow"
+      Principal = { Service = "kafka.amazonaws.com" }
+      Action    = "secretsmanager:GetSecretValue"
+      Resource  = "*"
+    }]
+  })
+}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name       = "AmazonMSK_%[1]s-2"
+  kms_key_id = aws_kms_key.test.key_id
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test2.id
+  secret_string = jsonencode({ username = "scram-user-2", password = "ScramSecretPassword456!" })
+}
+
+resource "aws_secretsmanager_secret_policy" "test2" {
+  secret_arn = aws_secretsmanager_secret.test2.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowMskAccess"
+      Effect    = "Allow"
+      Principal = { Service = "kafka.amazonaws.com" }
+      Action    = "secretsmanager:GetSecretValue"
+      Resource  = "*"
+    }]
+  })
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = "TLS"
+    }
+  }
+
+  client_authentication {
+    sasl {
+      scram = true
+    }
+  }
+}
+
+resource "aws_msk_scram_secret_association" "test" {
+  cluster_arn     = aws_msk_cluster.test.arn
+  secret_arn_list = [aws_secretsmanager_secret.test2.arn]
+
+  depends_on = [aws_secretsmanager_secret_version.test2, aws_secretsmanager_secret_policy.test2]
+}
+`, rName)
+}
@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMskServerlessCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskServerlessClusterCreate,
+		Read:   resourceAwsMskServerlessClusterRead,
+		Update: resourceAwsMskServerlessClusterUpdate,
+		Delete: resourceAwsMskServerlessClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]{0,63}$`), "name must consist of alphanumeric characters or hyphens, start with a letter or digit, and be at most 64 characters"),
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"client_authentication": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sasl": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iam": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsMskServerlessClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	input := &kafka.CreateClusterV2Input{
+		ClusterName: aws.String(d.Get("cluster_name").(string)),
+		Serverless: &kafka.ServerlessRequest{
+			VpcConfigs:           expandMskServerlessClusterVpcConfigs(d.Get("vpc_config").([]interface{})),
+			ClientAuthentication: expandMskServerlessClusterClientAuthentication(d.Get("client_authentication").([]interface{})),
+		},
+	}
+
+	if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().KafkaTags()
+	}
+
+	log.Printf("[DEBUG] Creating MSK Serverless Cluster: %s", input)
+	output, err := conn.CreateClusterV2(input)
+	if err != nil {
+		return fmt.Errorf("error creating MSK Serverless Cluster: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ClusterArn))
+
+	if err := resourceAwsMskServerlessClusterCreateWaiter(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MSK Serverless Cluster (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsMskServerlessClusterRead(d, meta)
+}
+
+func resourceAwsMskServerlessClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	out, err := conn.DescribeClusterV2(&kafka.DescribeClusterV2Input{
+		ClusterArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MSK Serverless Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading MSK Serverless Cluster (%s): %w", d.Id(), err)
+	}
+
+	cluster := out.ClusterInfo
+	if aws.StringValue(cluster.ClusterType) != kafka.ClusterTypeServerless || cluster.Serverless == nil {
+		return fmt.Errorf("MSK Cluster (%s) is not a serverless cluster; use aws_msk_cluster to manage provisioned clusters", d.Id())
+	}
+
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("cluster_uuid", mskClusterUuidFromArn(aws.StringValue(cluster.ClusterArn)))
+
+	if err := d.Set("vpc_config", flattenMskServerlessClusterVpcConfigs(cluster.Serverless.VpcConfigs)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	if err := d.Set("client_authentication", flattenMskServerlessClusterClientAuthentication(cluster.Serverless.ClientAuthentication)); err != nil {
+		return fmt.Errorf("error setting client_authentication: %w", err)
+	}
+
+	tags, err := keyvaluetags.KafkaListTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for MSK Serverless Cluster (%s): %w", d.Id(), err)
+	}
+	tags = tags.IgnoreAws().IgnoreConfig(meta.(*AWSClient).ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(meta.(*AWSClient).defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMskServerlessClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.KafkaUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags for MSK Serverless Cluster (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMskServerlessClusterRead(d, meta)
+}
+
+func resourceAwsMskServerlessClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	log.Printf("[DEBUG] Deleting MSK Serverless Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Serverless Cluster (%s): %w", d.Id(), err)
+	}
+
+	if err := resourceAwsMskClusterDeleteWaiter(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MSK Serverless Cluster (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMskServerlessClusterCreateWaiter(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateCreating},
+		Target:  []string{kafka.ClusterStateActive},
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.DescribeClusterV2(&kafka.DescribeClusterV2Input{
+				ClusterArn: aws.String(arn),
+			})
+			if err != nil {
+				return out, "", err
+			}
+
+			return out, aws.StringValue(out.ClusterInfo.State), nil
+		},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// mskClusterUuidFromArn extracts the trailing UUID from an MSK cluster ARN of
+// the form arn:aws:kafka:<region>:<account>:cluster/<name>/<uuid>.
+func mskClusterUuidFromArn(clusterArn string) string {
+	parts := strings.Split(clusterArn, "/")
+	if len(parts) == 0 {
+		return ""
+	}
+
+	return parts[len(parts)-1]
+}
+
+func expandMskServerlessClusterVpcConfigs(in []interface{}) []*kafka.VpcConfig {
+	configs := make([]*kafka.VpcConfig, 0, len(in))
+
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+
+		configs = append(configs, &kafka.VpcConfig{
+			SubnetIds:        expandStringList(m["subnet_ids"].([]interface{})),
+			SecurityGroupIds: expandStringList(m["security_group_ids"].([]interface{})),
+		})
+	}
+
+	return configs
+}
+
+func flattenMskServerlessClusterVpcConfigs(in []*kafka.VpcConfig) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(in))
+
+	for _, config := range in {
+		out = append(out, map[string]interface{}{
+			"subnet_ids":         aws.StringValueSlice(config.SubnetIds),
+			"security_group_ids": aws.StringValueSlice(config.SecurityGroupIds),
+		})
+	}
+
+	return out
+}
+
+func expandMskServerlessClusterClientAuthentication(in []interface{}) *kafka.ServerlessClientAuthentication {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	saslList, ok := m["sasl"].([]interface{})
+	if !ok || len(saslList) == 0 || saslList[0] == nil {
+		return nil
+	}
+	sasl := saslList[0].(map[string]interface{})
+
+	iamList, ok := sasl["iam"].([]interface{})
+	if !ok || len(iamList) == 0 || iamList[0] == nil {
+		return nil
+	}
+	iam := iamList[0].(map[string]interface{})
+
+	return &kafka.ServerlessClientAuthentication{
+		Sasl: &kafka.ServerlessSasl{
+			Iam: &kafka.Iam{
+				Enabled: aws.Bool(iam["enabled"].(bool)),
+			},
+		},
+	}
+}
+
+func flattenMskServerlessClusterClientAuthentication(in *kafka.ServerlessClientAuthentication) []map[string]interface{} {
+	if in == nil || in.Sasl == nil || in.Sasl.Iam == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"sasl": []map[string]interface{}{
+				{
+					"iam": []map[string]interface{}{
+						{"enabled": aws.BoolValue(in.Sasl.Iam.Enabled)},
+					},
+				},
+			},
+		},
+	}
+}
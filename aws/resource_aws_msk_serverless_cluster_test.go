@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSMskServerlessCluster_basic(t *testing.T) {
+	var cluster kafka.Cluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_serverless_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskServerlessClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskServerlessClusterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskServerlessClusterExists(resourceName, &cluster),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kafka", regexp.MustCompile(`cluster/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_uuid"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "client_authentication.0.sasl.0.iam.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMskServerlessCluster_tags(t *testing.T) {
+	var cluster kafka.Cluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_serverless_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskServerlessClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskServerlessClusterConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskServerlessClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccMskServerlessClusterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskServerlessClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMskServerlessClusterExists(n string, v *kafka.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		out, err := conn.DescribeClusterV2(&kafka.DescribeClusterV2Input{
+			ClusterArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *out.ClusterInfo
+		return nil
+	}
+}
+
+func testAccCheckMskServerlessClusterDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_serverless_cluster" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		_, err := conn.DescribeClusterV2(&kafka.DescribeClusterV2Input{
+			ClusterArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("MSK Serverless Cluster %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccMskServerlessClusterBaseConfig() string {
+	return `
+resource "aws_vpc" "example_vpc" {
+  cidr_block = "192.168.0.0/22"
+
+  tags = {
+    Name = "tf-testacc-msk-serverless-cluster-vpc"
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_subnet" "example_subnet_az1" {
+  vpc_id            = aws_vpc.example_vpc.id
+  cidr_block        = "192.168.0.0/24"
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = "tf-testacc-msk-serverless-cluster-subnet-az1"
+  }
+}
+
+resource "aws_subnet" "example_subnet_az2" {
+  vpc_id            = aws_vpc.example_vpc.id
+  cidr_block        = "192.168.1.0/24"
+  availability_zone = data.aws_availability_zones.available.names[1]
+
+  tags = {
+    Name = "tf-testacc-msk-serverless-cluster-subnet-az2"
+  }
+}
+
+resource "aws_subnet" "example_subnet_az3" {
+  vpc_id            = aws_vpc.example_vpc.id
+  cidr_block        = "192.168.2.0/24"
+  availability_zone = data.aws_availability_zones.available.names[2]
+
+  tags = {
+    Name = "tf-testacc-msk-serverless-cluster-subnet-az3"
+  }
+}
+
+resource "aws_security_group" "example_sg" {
+  vpc_id = aws_vpc.example_vpc.id
+}
+`
+}
+
+func testAccMskServerlessClusterConfig_basic(rName string) string {
+	return testAccMskServerlessClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_serverless_cluster" "test" {
+  cluster_name = %[1]q
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    security_group_ids = [aws_security_group.example_sg.id]
+  }
+
+  client_authentication {
+    sasl {
+      iam {
+        enabled = true
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMskServerlessClusterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccMskServerlessClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_serverless_cluster" "test" {
+  cluster_name = %[1]q
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    security_group_ids = [aws_security_group.example_sg.id]
+  }
+
+  client_authentication {
+    sasl {
+      iam {
+        enabled = true
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccMskServerlessClusterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccMskServerlessClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_serverless_cluster" "test" {
+  cluster_name = %[1]q
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    security_group_ids = [aws_security_group.example_sg.id]
+  }
+
+  client_authentication {
+    sasl {
+      iam {
+        enabled = true
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/aws/aws-msk-iam-sasl-signer-go/signer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceAwsMskTopic manages a Kafka topic directly on an MSK cluster's
+// brokers. Unlike the rest of this package it does not call the MSK control
+// plane API (the kafka.Kafka client) for its CRUD operations -- topics are
+// cluster state, not MSK resource state -- so it opens an admin connection
+// to the brokers over the Kafka protocol itself, authenticating with
+// SASL/IAM (SigV4-signed) or TLS client-broker encryption depending on how
+// the referenced cluster's client_authentication is configured.
+func resourceAwsMskTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskTopicCreate,
+		Read:   resourceAwsMskTopicRead,
+		Update: resourceAwsMskTopicUpdate,
+		Delete: resourceAwsMskTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsMskTopicImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"partitions": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"replication_factor": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"config": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsMskTopicCreate(d *schema.ResourceData, meta interface{}) error {
+	clusterArn := d.Get("cluster_arn").(string)
+	name := d.Get("name").(string)
+
+	admin, err := mskTopicAdminClient(meta, clusterArn)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	log.Printf("[DEBUG] Creating MSK Topic: %s on cluster %s", name, clusterArn)
+	err = admin.CreateTopic(name, &sarama.TopicDetail{
+		NumPartitions:     int32(d.Get("partitions").(int)),
+		ReplicationFactor: int16(d.Get("replication_factor").(int)),
+		ConfigEntries:     expandMskTopicConfigMap(d.Get("config").(map[string]interface{})),
+	}, false)
+	if err != nil {
+		return fmt.Errorf("error creating MSK Topic (%s) on cluster (%s): %w", name, clusterArn, err)
+	}
+
+	d.SetId(mskTopicId(clusterArn, name))
+
+	return resourceAwsMskTopicRead(d, meta)
+}
+
+func resourceAwsMskTopicRead(d *schema.ResourceData, meta interface{}) error {
+	clusterArn, name, err := mskTopicParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	admin, err := mskTopicAdminClient(meta, clusterArn)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	topics, err := admin.ListTopics()
+	if err != nil {
+		return fmt.Errorf("error listing topics on MSK Cluster (%s): %w", clusterArn, err)
+	}
+
+	detail, ok := topics[name]
+	if !ok {
+		log.Printf("[WARN] MSK Topic (%s) not found on cluster (%s), removing from state", name, clusterArn)
+		d.SetId("")
+		return nil
+	}
+
+	configEntries, err := admin.DescribeConfig(sarama.ConfigResource{
+		Type: sarama.TopicResource,
+		Name: name,
+	})
+	if err != nil {
+		return fmt.Errorf("error describing MSK Topic (%s) config on cluster (%s): %w", name, clusterArn, err)
+	}
+
+	d.Set("cluster_arn", clusterArn)
+	d.Set("name", name)
+	d.Set("partitions", detail.NumPartitions)
+	d.Set("replication_factor", detail.ReplicationFactor)
+	d.Set("config", flattenMskTopicConfig(configEntries))
+
+	return nil
+}
+
+func resourceAwsMskTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+	clusterArn, name, err := mskTopicParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	admin, err := mskTopicAdminClient(meta, clusterArn)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	if d.HasChange("partitions") {
+		o, n := d.GetChange("partitions")
+		if n.(int) < o.(int) {
+			return fmt.Errorf("error updating MSK Topic (%s): partitions cannot be decreased from %d to %d", name, o.(int), n.(int))
+		}
+
+		log.Printf("[DEBUG] Increasing MSK Topic (%s) partitions on cluster (%s) to %d", name, clusterArn, n.(int))
+		if err := admin.CreatePartitions(name, int32(n.(int)), nil, false); err != nil {
+			return fmt.Errorf("error increasing MSK Topic (%s) partitions on cluster (%s): %w", name, clusterArn, err)
+		}
+	}
+
+	if d.HasChange("config") {
+		log.Printf("[DEBUG] Updating MSK Topic (%s) config on cluster (%s)", name, clusterArn)
+		err := admin.AlterConfig(sarama.TopicResource, name, expandMskTopicConfigMap(d.Get("config").(map[string]interface{})), false)
+		if err != nil {
+			return fmt.Errorf("error updating MSK Topic (%s) config on cluster (%s): %w", name, clusterArn, err)
+		}
+	}
+
+	return resourceAwsMskTopicRead(d, meta)
+}
+
+func resourceAwsMskTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	clusterArn, name, err := mskTopicParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	admin, err := mskTopicAdminClient(meta, clusterArn)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	log.Printf("[DEBUG] Deleting MSK Topic: %s on cluster %s", name, clusterArn)
+	if err := admin.DeleteTopic(name); err != nil && err != sarama.ErrUnknownTopicOrPartition {
+		return fmt.Errorf("error deleting MSK Topic (%s) on cluster (%s): %w", name, clusterArn, err)
+	}
+
+	return nil
+}
+
+func resourceAwsMskTopicImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clusterArn, name, err := mskTopicParseId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("cluster_arn", clusterArn)
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func mskTopicId(clusterArn, name string) string {
+	return fmt.Sprintf("%s,%s", clusterArn, name)
+}
+
+func mskTopicParseId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected cluster-arn,name", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandMskTopicConfigMap(in map[string]interface{}) map[string]*string {
+	out := make(map[string]*string, len(in))
+
+	for k, v := range in {
+		out[k] = aws.String(v.(string))
+	}
+
+	return out
+}
+
+func flattenMskTopicConfig(in []sarama.ConfigEntry) map[string]string {
+	out := make(map[string]string)
+
+	for _, entry := range in {
+		if entry.Default || entry.Source != sarama.SourceTopic {
+			continue
+		}
+		out[entry.Name] = entry.Value
+	}
+
+	return out
+}
+
+// mskTopicAdminClient resolves the cluster's bootstrap brokers and opens a
+// Kafka admin connection, preferring SASL/IAM brokers -- authenticated with a
+// SigV4-signed token minted by mskIAMTokenProvider via the OAUTHBEARER
+// mechanism -- and falling back to plain TLS client-broker encryption when
+// the cluster has no SASL/IAM bootstrap broker string.
+func mskTopicAdminClient(meta interface{}, clusterArn string) (sarama.ClusterAdmin, error) {
+	client := meta.(*AWSClient)
+
+	brokers, err := client.kafkaconn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: aws.String(clusterArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting MSK Cluster (%s) bootstrap brokers: %w", clusterArn, err)
+	}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_6_0_0
+	config.Net.TLS.Enable = true
+	config.Net.TLS.Config = &tls.Config{}
+
+	var brokerString string
+	if iamBrokerString := aws.StringValue(brokers.BootstrapBrokerStringSaslIam); iamBrokerString != "" {
+		brokerString = iamBrokerString
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+		config.Net.SASL.TokenProvider = &mskIAMTokenProvider{region: client.region}
+	} else if tlsBrokerString := aws.StringValue(brokers.BootstrapBrokerStringTls); tlsBrokerString != "" {
+		brokerString = tlsBrokerString
+	} else {
+		return nil, fmt.Errorf("MSK Cluster (%s) has no SASL/IAM or TLS bootstrap brokers; aws_msk_topic requires SASL/IAM client authentication or encryption_info.0.encryption_in_transit.0.client_broker set to TLS", clusterArn)
+	}
+
+	admin, err := sarama.NewClusterAdmin(strings.Split(brokerString, ","), config)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to MSK Cluster (%s) brokers: %w", clusterArn, err)
+	}
+
+	return admin, nil
+}
+
+// mskIAMTokenProvider mints the short-lived SigV4-signed auth tokens MSK's
+// "AWS_MSK_IAM" SASL mechanism expects, surfaced to Sarama over the
+// standard OAUTHBEARER mechanism per aws-msk-iam-sasl-signer-go.
+type mskIAMTokenProvider struct {
+	region string
+}
+
+func (p *mskIAMTokenProvider) Token() (*sarama.AccessToken, error) {
+	token, _, err := signer.GenerateAuthToken(context.Background(), p.region)
+	if err != nil {
+		return nil, fmt.Errorf("error generating MSK IAM auth token: %w", err)
+	}
+
+	return &sarama.AccessToken{Token: token}, nil
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSMskTopic_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_topic.test"
+	clusterResourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskTopicConfig(rName, 3, map[string]string{"retention.ms": "86400000"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskTopicExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", clusterResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "partitions", "3"),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "3"),
+					resource.TestCheckResourceAttr(resourceName, "config.retention.ms", "86400000"),
+				),
+			},
+			{
+				Config: testAccMskTopicConfig(rName, 6, map[string]string{"retention.ms": "172800000", "cleanup.policy": "compact"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partitions", "6"),
+					resource.TestCheckResourceAttr(resourceName, "config.retention.ms", "172800000"),
+					resource.TestCheckResourceAttr(resourceName, "config.cleanup.policy", "compact"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMskTopicExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterArn, name, err := mskTopicParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		admin, err := mskTopicAdminClient(testAccProvider.Meta(), clusterArn)
+		if err != nil {
+			return err
+		}
+		defer admin.Close()
+
+		topics, err := admin.ListTopics()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := topics[name]; !ok {
+			return fmt.Errorf("MSK Topic (%s) does not exist on cluster (%s)", name, clusterArn)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMskTopicDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_topic" {
+			continue
+		}
+
+		clusterArn, name, err := mskTopicParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		admin, err := mskTopicAdminClient(testAccProvider.Meta(), clusterArn)
+		if err != nil {
+			// The cluster itself is gone.
+			continue
+		}
+		defer admin.Close()
+
+		topics, err := admin.ListTopics()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := topics[name]; ok {
+			return fmt.Errorf("MSK Topic (%s) still exists on cluster (%s)", name, clusterArn)
+		}
+	}
+
+	return nil
+}
+
+func testAccMskTopicConfig(rName string, partitions int, config map[string]string) string {
+	var configLines string
+	for k, v := range config {
+		configLines += fmt.Sprintf("    %q = %q\n", k, v)
+	}
+
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = "TLS"
+    }
+  }
+}
+
+resource "aws_msk_topic" "test" {
+  cluster_arn        = aws_msk_cluster.test.arn
+  name               = %[1]q
+  partitions         = %[2]d
+  replication_factor = 3
+
+  config = {
+%[3]s  }
+}
+`, rName, partitions, configLines)
+}
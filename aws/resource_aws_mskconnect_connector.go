@@ -0,0 +1,737 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsMskConnectConnector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskConnectConnectorCreate,
+		Read:   resourceAwsMskConnectConnectorRead,
+		Update: resourceAwsMskConnectConnectorUpdate,
+		Delete: resourceAwsMskConnectConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kafkaconnect_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connector_configuration": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"kafka_cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apache_kafka_cluster": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bootstrap_servers": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"vpc": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"security_groups": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"subnets": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kafka_cluster_client_authentication": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								kafkaconnect.KafkaClusterClientAuthenticationTypeNone,
+								kafkaconnect.KafkaClusterClientAuthenticationTypeIam,
+							}, false),
+						},
+					},
+				},
+			},
+			"kafka_cluster_encryption_in_transit": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								kafkaconnect.KafkaClusterEncryptionInTransitTypePlaintext,
+								kafkaconnect.KafkaClusterEncryptionInTransitTypeTls,
+							}, false),
+						},
+					},
+				},
+			},
+			"plugin": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_plugin": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"revision": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"worker_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"revision": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"capacity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"autoscaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min_worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"mcu_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"scale_in_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpu_utilization_percentage": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 100),
+												},
+											},
+										},
+									},
+									"scale_out_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpu_utilization_percentage": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 100),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"provisioned_capacity": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"mcu_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"log_delivery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worker_log_delivery": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloudwatch_logs": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"log_group": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"firehose": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"delivery_stream": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"bucket": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMskConnectConnectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	input := &kafkaconnect.CreateConnectorInput{
+		ConnectorName:                    aws.String(d.Get("name").(string)),
+		KafkaConnectVersion:              aws.String(d.Get("kafkaconnect_version").(string)),
+		ConnectorConfiguration:           expandStringMap(d.Get("connector_configuration").(map[string]interface{})),
+		ServiceExecutionRoleArn:          aws.String(d.Get("service_execution_role_arn").(string)),
+		KafkaCluster:                     expandMskConnectKafkaCluster(d.Get("kafka_cluster").([]interface{})),
+		KafkaClusterClientAuthentication: expandMskConnectKafkaClusterClientAuthentication(d.Get("kafka_cluster_client_authentication").([]interface{})),
+		KafkaClusterEncryptionInTransit:  expandMskConnectKafkaClusterEncryptionInTransit(d.Get("kafka_cluster_encryption_in_transit").([]interface{})),
+		Plugins:                          expandMskConnectPlugins(d.Get("plugin").([]interface{})),
+		Capacity:                         expandMskConnectCapacity(d.Get("capacity").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ConnectorDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("worker_configuration"); ok {
+		input.WorkerConfiguration = expandMskConnectWorkerConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_delivery"); ok {
+		input.LogDelivery = expandMskConnectLogDelivery(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MSK Connect Connector: %s", input)
+	output, err := conn.CreateConnector(input)
+	if err != nil {
+		return fmt.Errorf("error creating MSK Connect Connector (%s): %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorArn))
+
+	if err := waitForMskConnectConnectorCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MSK Connect Connector (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsMskConnectConnectorRead(d, meta)
+}
+
+func resourceAwsMskConnectConnectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	out, err := conn.DescribeConnector(&kafkaconnect.DescribeConnectorInput{
+		ConnectorArn: aws.String(d.Id()),
+	})
+	if isMskConnectNotFound(err) {
+		log.Printf("[WARN] MSK Connect Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading MSK Connect Connector (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", out.ConnectorArn)
+	d.Set("name", out.ConnectorName)
+	d.Set("description", out.ConnectorDescription)
+	d.Set("version", out.CurrentVersion)
+	d.Set("kafkaconnect_version", out.KafkaConnectVersion)
+	d.Set("service_execution_role_arn", out.ServiceExecutionRoleArn)
+	d.Set("connector_configuration", aws.StringValueMap(out.ConnectorConfiguration))
+
+	return nil
+}
+
+func resourceAwsMskConnectConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	if d.HasChange("capacity.0.autoscaling.0.max_worker_count") || d.HasChange("capacity.0.autoscaling.0.min_worker_count") || d.HasChange("capacity.0.provisioned_capacity.0.worker_count") {
+		_, err := conn.UpdateConnector(&kafkaconnect.UpdateConnectorInput{
+			ConnectorArn:   aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("version").(string)),
+			Capacity:       expandMskConnectCapacityUpdate(d.Get("capacity").([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating MSK Connect Connector (%s) capacity: %w", d.Id(), err)
+		}
+
+		if err := waitForMskConnectConnectorUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MSK Connect Connector (%s) capacity update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMskConnectConnectorRead(d, meta)
+}
+
+func resourceAwsMskConnectConnectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	log.Printf("[DEBUG] Deleting MSK Connect Connector: %s", d.Id())
+	_, err := conn.DeleteConnector(&kafkaconnect.DeleteConnectorInput{
+		ConnectorArn: aws.String(d.Id()),
+	})
+	if isMskConnectNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Connect Connector (%s): %w", d.Id(), err)
+	}
+
+	return waitForMskConnectConnectorDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+}
+
+func waitForMskConnectConnectorCreation(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateCreating},
+		Target:  []string{kafkaconnect.ConnectorStateRunning},
+		Refresh: mskConnectConnectorStateRefresh(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func waitForMskConnectConnectorUpdate(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateUpdating},
+		Target:  []string{kafkaconnect.ConnectorStateRunning},
+		Refresh: mskConnectConnectorStateRefresh(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func waitForMskConnectConnectorDeletion(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateDeleting},
+		Target:  []string{},
+		Refresh: mskConnectConnectorStateRefresh(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func mskConnectConnectorStateRefresh(conn *kafkaconnect.KafkaConnect, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.DescribeConnector(&kafkaconnect.DescribeConnectorInput{
+			ConnectorArn: aws.String(arn),
+		})
+		if isMskConnectNotFound(err) {
+			return out, "", nil
+		}
+		if err != nil {
+			return out, "", err
+		}
+
+		return out, aws.StringValue(out.ConnectorState), nil
+	}
+}
+
+func expandMskConnectKafkaCluster(in []interface{}) *kafkaconnect.KafkaCluster {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	apacheList := m["apache_kafka_cluster"].([]interface{})
+	if len(apacheList) == 0 || apacheList[0] == nil {
+		return nil
+	}
+	apache := apacheList[0].(map[string]interface{})
+
+	vpcList := apache["vpc"].([]interface{})
+	vpc := vpcList[0].(map[string]interface{})
+
+	return &kafkaconnect.KafkaCluster{
+		ApacheKafkaCluster: &kafkaconnect.ApacheKafkaCluster{
+			BootstrapServers: aws.String(apache["bootstrap_servers"].(string)),
+			Vpc: &kafkaconnect.Vpc{
+				SecurityGroups: expandStringSet(vpc["security_groups"].(*schema.Set)),
+				Subnets:        expandStringSet(vpc["subnets"].(*schema.Set)),
+			},
+		},
+	}
+}
+
+func expandMskConnectKafkaClusterClientAuthentication(in []interface{}) *kafkaconnect.KafkaClusterClientAuthentication {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	return &kafkaconnect.KafkaClusterClientAuthentication{
+		AuthenticationType: aws.String(m["authentication_type"].(string)),
+	}
+}
+
+func expandMskConnectKafkaClusterEncryptionInTransit(in []interface{}) *kafkaconnect.KafkaClusterEncryptionInTransit {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	return &kafkaconnect.KafkaClusterEncryptionInTransit{
+		EncryptionType: aws.String(m["encryption_type"].(string)),
+	}
+}
+
+func expandMskConnectPlugins(in []interface{}) []*kafkaconnect.Plugin {
+	plugins := make([]*kafkaconnect.Plugin, 0, len(in))
+	for _, raw := range in {
+		m := raw.(map[string]interface{})
+		customPluginList := m["custom_plugin"].([]interface{})
+		if len(customPluginList) == 0 || customPluginList[0] == nil {
+			continue
+		}
+		customPlugin := customPluginList[0].(map[string]interface{})
+
+		plugins = append(plugins, &kafkaconnect.Plugin{
+			CustomPlugin: &kafkaconnect.CustomPlugin{
+				CustomPluginArn: aws.String(customPlugin["arn"].(string)),
+				Revision:        aws.Int64(int64(customPlugin["revision"].(int))),
+			},
+		})
+	}
+
+	return plugins
+}
+
+func expandMskConnectWorkerConfiguration(in []interface{}) *kafkaconnect.WorkerConfiguration {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	return &kafkaconnect.WorkerConfiguration{
+		WorkerConfigurationArn: aws.String(m["arn"].(string)),
+		Revision:               aws.Int64(int64(m["revision"].(int))),
+	}
+}
+
+func expandMskConnectCapacity(in []interface{}) *kafkaconnect.Capacity {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	capacity := &kafkaconnect.Capacity{}
+
+	if v, ok := m["autoscaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a := v[0].(map[string]interface{})
+		capacity.AutoScaling = &kafkaconnect.AutoScaling{
+			MaxWorkerCount: aws.Int64(int64(a["max_worker_count"].(int))),
+			MinWorkerCount: aws.Int64(int64(a["min_worker_count"].(int))),
+			McuCount:       aws.Int64(int64(a["mcu_count"].(int))),
+		}
+
+		if sp, ok := a["scale_in_policy"].([]interface{}); ok && len(sp) > 0 && sp[0] != nil {
+			m := sp[0].(map[string]interface{})
+			capacity.AutoScaling.ScaleInPolicy = &kafkaconnect.ScaleInPolicy{
+				CpuUtilizationPercentage: aws.Int64(int64(m["cpu_utilization_percentage"].(int))),
+			}
+		}
+
+		if sp, ok := a["scale_out_policy"].([]interface{}); ok && len(sp) > 0 && sp[0] != nil {
+			m := sp[0].(map[string]interface{})
+			capacity.AutoScaling.ScaleOutPolicy = &kafkaconnect.ScaleOutPolicy{
+				CpuUtilizationPercentage: aws.Int64(int64(m["cpu_utilization_percentage"].(int))),
+			}
+		}
+	}
+
+	if v, ok := m["provisioned_capacity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		capacity.ProvisionedCapacity = &kafkaconnect.ProvisionedCapacity{
+			WorkerCount: aws.Int64(int64(p["worker_count"].(int))),
+			McuCount:    aws.Int64(int64(p["mcu_count"].(int))),
+		}
+	}
+
+	return capacity
+}
+
+func expandMskConnectCapacityUpdate(in []interface{}) *kafkaconnect.CapacityUpdate {
+	capacity := expandMskConnectCapacity(in)
+	if capacity == nil {
+		return nil
+	}
+
+	update := &kafkaconnect.CapacityUpdate{}
+	if capacity.AutoScaling != nil {
+		update.AutoScaling = &kafkaconnect.AutoScalingUpdate{
+			MaxWorkerCount: capacity.AutoScaling.MaxWorkerCount,
+			MinWorkerCount: capacity.AutoScaling.MinWorkerCount,
+			McuCount:       capacity.AutoScaling.McuCount,
+			ScaleInPolicy:  capacity.AutoScaling.ScaleInPolicy,
+			ScaleOutPolicy: capacity.AutoScaling.ScaleOutPolicy,
+		}
+	}
+	if capacity.ProvisionedCapacity != nil {
+		update.ProvisionedCapacity = &kafkaconnect.ProvisionedCapacityUpdate{
+			WorkerCount: capacity.ProvisionedCapacity.WorkerCount,
+			McuCount:    capacity.ProvisionedCapacity.McuCount,
+		}
+	}
+
+	return update
+}
+
+func expandMskConnectLogDelivery(in []interface{}) *kafkaconnect.LogDelivery {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	workerLogDeliveryList := m["worker_log_delivery"].([]interface{})
+	if len(workerLogDeliveryList) == 0 || workerLogDeliveryList[0] == nil {
+		return nil
+	}
+	workerLogDelivery := workerLogDeliveryList[0].(map[string]interface{})
+
+	delivery := &kafkaconnect.WorkerLogDelivery{}
+
+	if v, ok := workerLogDelivery["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cw := v[0].(map[string]interface{})
+		delivery.CloudWatchLogs = &kafkaconnect.CloudWatchLogsLogDelivery{
+			Enabled:  aws.Bool(cw["enabled"].(bool)),
+			LogGroup: aws.String(cw["log_group"].(string)),
+		}
+	}
+
+	if v, ok := workerLogDelivery["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fh := v[0].(map[string]interface{})
+		delivery.Firehose = &kafkaconnect.FirehoseLogDelivery{
+			Enabled:        aws.Bool(fh["enabled"].(bool)),
+			DeliveryStream: aws.String(fh["delivery_stream"].(string)),
+		}
+	}
+
+	if v, ok := workerLogDelivery["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s3 := v[0].(map[string]interface{})
+		delivery.S3 = &kafkaconnect.S3LogDelivery{
+			Enabled: aws.Bool(s3["enabled"].(bool)),
+			Bucket:  aws.String(s3["bucket"].(string)),
+			Prefix:  aws.String(s3["prefix"].(string)),
+		}
+	}
+
+	return &kafkaconnect.LogDelivery{
+		WorkerLogDelivery: delivery,
+	}
+}
@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
+		Name: "aws_mskconnect_connector",
+		F:    testSweepMskConnectConnectors,
+	})
+}
+
+func testSweepMskConnectConnectors(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*AWSClient).kafkaconnectconn
+
+	out, err := conn.ListConnectors(&kafkaconnect.ListConnectorsInput{})
+	if err != nil {
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] skipping mskconnect connector sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving MSK Connect Connectors: %s", err)
+	}
+
+	for _, connector := range out.Connectors {
+		log.Printf("[INFO] Deleting MSK Connect Connector: %s", *connector.ConnectorArn)
+		_, err := conn.DeleteConnector(&kafkaconnect.DeleteConnectorInput{
+			ConnectorArn: connector.ConnectorArn,
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete MSK Connect Connector %s: %s", *connector.ConnectorArn, err)
+		}
+	}
+	return nil
+}
+
+// TestAccAWSMskConnectConnector_basic stands up an MSK cluster using the
+// existing acceptance test scaffolding and attaches a file-source connector
+// to prove the plugin/connector plumbing end-to-end. No worker
+// configuration is attached.
+func TestAccAWSMskConnectConnector_basic(t *testing.T) {
+	var cluster kafkaconnect.ConnectorSummary
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mskconnect_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMsk(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskConnectConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskConnectConnectorConfig_capacity(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskConnectConnectorExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.0.worker_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "plugin.#", "1"),
+				),
+			},
+			{
+				Config: testAccMskConnectConnectorConfig_capacity(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskConnectConnectorExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.0.worker_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMskConnectConnectorExists(n string, v *kafkaconnect.ConnectorSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconnectconn
+		out, err := conn.DescribeConnector(&kafkaconnect.DescribeConnectorInput{
+			ConnectorArn: &rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		v.ConnectorArn = out.ConnectorArn
+		v.ConnectorName = out.ConnectorName
+		return nil
+	}
+}
+
+func testAccCheckMskConnectConnectorDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mskconnect_connector" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconnectconn
+		_, err := conn.DescribeConnector(&kafkaconnect.DescribeConnectorInput{
+			ConnectorArn: &rs.Primary.ID,
+		})
+		if isMskConnectNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("MSK Connect Connector %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccMskConnectConnectorConfig_capacity(rName string, workerCount int) string {
+	return testAccMskClusterBaseConfig() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "test/file-source.zip"
+  source = "test-fixtures/mskconnect/file-source.zip"
+}
+
+resource "aws_mskconnect_custom_plugin" "test" {
+  name         = %[1]q
+  content_type = "ZIP"
+
+  location {
+    s3 {
+      bucket_arn = aws_s3_bucket.test.arn
+      file_key   = aws_s3_bucket_object.test.key
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "kafkaconnect.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_mskconnect_connector" "test" {
+  name                 = %[1]q
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    provisioned_capacity {
+      worker_count = %[2]d
+    }
+  }
+
+  connector_configuration = {
+    "connector.class"                = "FileStreamSourceConnector"
+    "tasks.max"                      = "1"
+    "topic"                          = "test"
+    "file"                           = "/tmp/test"
+    "key.converter"                  = "org.apache.kafka.connect.storage.StringConverter"
+    "value.converter"                = "org.apache.kafka.connect.storage.StringConverter"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = aws_msk_cluster.test.bootstrap_brokers_tls
+
+      vpc {
+        security_groups = [aws_security_group.example_sg.id]
+        subnets          = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "TLS"
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = aws_mskconnect_custom_plugin.test.arn
+      revision = aws_mskconnect_custom_plugin.test.latest_revision
+    }
+  }
+
+  service_execution_role_arn = aws_iam_role.test.arn
+}
+`, rName, workerCount)
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsMskConnectCustomPlugin() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskConnectCustomPluginCreate,
+		Read:   resourceAwsMskConnectCustomPluginRead,
+		Delete: resourceAwsMskConnectCustomPluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					kafkaconnect.CustomPluginContentTypeJar,
+					kafkaconnect.CustomPluginContentTypeZip,
+				}, false),
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"file_key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"latest_revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMskConnectCustomPluginCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	input := &kafkaconnect.CreateCustomPluginInput{
+		Name:        aws.String(d.Get("name").(string)),
+		ContentType: aws.String(d.Get("content_type").(string)),
+		Location:    expandMskConnectCustomPluginLocation(d.Get("location").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MSK Connect Custom Plugin: %s", input)
+	output, err := conn.CreateCustomPlugin(input)
+	if err != nil {
+		return fmt.Errorf("error creating MSK Connect Custom Plugin (%s): %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.CustomPluginArn))
+
+	if err := waitForMskConnectCustomPluginCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MSK Connect Custom Plugin (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsMskConnectCustomPluginRead(d, meta)
+}
+
+func resourceAwsMskConnectCustomPluginRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	plugin, err := describeMskConnectCustomPlugin(conn, d.Id())
+	if isMskConnectNotFound(err) {
+		log.Printf("[WARN] MSK Connect Custom Plugin (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading MSK Connect Custom Plugin (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", plugin.CustomPluginArn)
+	d.Set("name", plugin.Name)
+	d.Set("description", plugin.Description)
+	d.Set("content_type", plugin.LatestRevision.ContentType)
+	d.Set("latest_revision", plugin.LatestRevision.Revision)
+	d.Set("state", plugin.CustomPluginState)
+
+	if err := d.Set("location", flattenMskConnectCustomPluginLocation(plugin.LatestRevision.Location)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMskConnectCustomPluginDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	log.Printf("[DEBUG] Deleting MSK Connect Custom Plugin: %s", d.Id())
+	_, err := conn.DeleteCustomPlugin(&kafkaconnect.DeleteCustomPluginInput{
+		CustomPluginArn: aws.String(d.Id()),
+	})
+	if isMskConnectNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Connect Custom Plugin (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeMskConnectCustomPlugin(conn *kafkaconnect.KafkaConnect, arn string) (*kafkaconnect.CustomPluginSummary, error) {
+	out, err := conn.DescribeCustomPlugin(&kafkaconnect.DescribeCustomPluginInput{
+		CustomPluginArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaconnect.CustomPluginSummary{
+		CustomPluginArn:   out.CustomPluginArn,
+		Name:              out.Name,
+		Description:       out.Description,
+		CustomPluginState: out.CustomPluginState,
+		LatestRevision:    out.LatestRevision,
+	}, nil
+}
+
+func isMskConnectNotFound(err error) bool {
+	return isAWSErr(err, kafkaconnect.ErrCodeNotFoundException, "")
+}
+
+func waitForMskConnectCustomPluginCreation(conn *kafkaconnect.KafkaConnect, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.CustomPluginStateCreating},
+		Target:  []string{kafkaconnect.CustomPluginStateActive},
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.DescribeCustomPlugin(&kafkaconnect.DescribeCustomPluginInput{
+				CustomPluginArn: aws.String(arn),
+			})
+			if err != nil {
+				return out, "", err
+			}
+
+			return out, aws.StringValue(out.CustomPluginState), nil
+		},
+		Timeout: 10 * time.Minute,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func expandMskConnectCustomPluginLocation(in []interface{}) *kafkaconnect.CustomPluginLocation {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	m := in[0].(map[string]interface{})
+
+	s3List, ok := m["s3"].([]interface{})
+	if !ok || len(s3List) == 0 || s3List[0] == nil {
+		return nil
+	}
+	s3 := s3List[0].(map[string]interface{})
+
+	location := &kafkaconnect.CustomPluginLocation{
+		S3Location: &kafkaconnect.S3Location{
+			BucketArn: aws.String(s3["bucket_arn"].(string)),
+			FileKey:   aws.String(s3["file_key"].(string)),
+		},
+	}
+
+	if v, ok := s3["object_version"].(string); ok && v != "" {
+		location.S3Location.ObjectVersion = aws.String(v)
+	}
+
+	return location
+}
+
+func flattenMskConnectCustomPluginLocation(in *kafkaconnect.CustomPluginLocationDescription) []map[string]interface{} {
+	if in == nil || in.S3Location == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"s3": []map[string]interface{}{
+				{
+					"bucket_arn":     aws.StringValue(in.S3Location.BucketArn),
+					"file_key":       aws.StringValue(in.S3Location.FileKey),
+					"object_version": aws.StringValue(in.S3Location.ObjectVersion),
+				},
+			},
+		},
+	}
+}
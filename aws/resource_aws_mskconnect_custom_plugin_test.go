@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
+		Name: "aws_mskconnect_custom_plugin",
+		F:    testSweepMskConnectCustomPlugins,
+	})
+}
+
+func testSweepMskConnectCustomPlugins(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*AWSClient).kafkaconnectconn
+
+	out, err := conn.ListCustomPlugins(&kafkaconnect.ListCustomPluginsInput{})
+	if err != nil {
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] skipping mskconnect custom plugin sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving MSK Connect Custom Plugins: %s", err)
+	}
+
+	for _, plugin := range out.CustomPlugins {
+		log.Printf("[INFO] Deleting MSK Connect Custom Plugin: %s", *plugin.CustomPluginArn)
+		_, err := conn.DeleteCustomPlugin(&kafkaconnect.DeleteCustomPluginInput{
+			CustomPluginArn: plugin.CustomPluginArn,
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete MSK Connect Custom Plugin %s: %s", *plugin.CustomPluginArn, err)
+		}
+	}
+	return nil
+}
+
+func TestAccAWSMskConnectCustomPlugin_basic(t *testing.T) {
+	var plugin kafkaconnect.CustomPluginSummary
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mskconnect_custom_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskConnectCustomPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskConnectCustomPluginConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskConnectCustomPluginExists(resourceName, &plugin),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", kafkaconnect.CustomPluginContentTypeZip),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.s3.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMskConnectCustomPluginExists(n string, plugin *kafkaconnect.CustomPluginSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconnectconn
+		out, err := describeMskConnectCustomPlugin(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*plugin = *out
+		return nil
+	}
+}
+
+func testAccCheckMskConnectCustomPluginDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mskconnect_custom_plugin" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconnectconn
+		_, err := describeMskConnectCustomPlugin(conn, rs.Primary.ID)
+		if isMskConnectNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("MSK Connect Custom Plugin %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccMskConnectCustomPluginConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "test/debezium.zip"
+  source = "test-fixtures/mskconnect/debezium.zip"
+}
+
+resource "aws_mskconnect_custom_plugin" "test" {
+  name         = %[1]q
+  content_type = "ZIP"
+
+  location {
+    s3 {
+      bucket_arn = aws_s3_bucket.test.arn
+      file_key   = aws_s3_bucket_object.test.key
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsMskConnectWorkerConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskConnectWorkerConfigurationCreate,
+		Read:   resourceAwsMskConnectWorkerConfigurationRead,
+		Delete: resourceAwsMskConnectWorkerConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"properties_file_content": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"latest_revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMskConnectWorkerConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	input := &kafkaconnect.CreateWorkerConfigurationInput{
+		Name:                  aws.String(d.Get("name").(string)),
+		PropertiesFileContent: aws.String(base64.StdEncoding.EncodeToString([]byte(d.Get("properties_file_content").(string)))),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MSK Connect Worker Configuration: %s", input)
+	output, err := conn.CreateWorkerConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error creating MSK Connect Worker Configuration (%s): %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkerConfigurationArn))
+
+	return resourceAwsMskConnectWorkerConfigurationRead(d, meta)
+}
+
+func resourceAwsMskConnectWorkerConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	out, err := conn.DescribeWorkerConfiguration(&kafkaconnect.DescribeWorkerConfigurationInput{
+		WorkerConfigurationArn: aws.String(d.Id()),
+	})
+	if isMskConnectNotFound(err) {
+		log.Printf("[WARN] MSK Connect Worker Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading MSK Connect Worker Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", out.WorkerConfigurationArn)
+	d.Set("name", out.Name)
+	d.Set("description", out.Description)
+	d.Set("latest_revision", out.LatestRevision.Revision)
+
+	if out.LatestRevision.PropertiesFileContent != nil {
+		decoded, err := base64.StdEncoding.DecodeString(aws.StringValue(out.LatestRevision.PropertiesFileContent))
+		if err != nil {
+			return fmt.Errorf("error decoding properties_file_content: %w", err)
+		}
+		d.Set("properties_file_content", string(decoded))
+	}
+
+	return nil
+}
+
+func resourceAwsMskConnectWorkerConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconnectconn
+
+	log.Printf("[DEBUG] Deleting MSK Connect Worker Configuration: %s", d.Id())
+	_, err := conn.DeleteWorkerConfiguration(&kafkaconnect.DeleteWorkerConfigurationInput{
+		WorkerConfigurationArn: aws.String(d.Id()),
+	})
+	if isMskConnectNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Connect Worker Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
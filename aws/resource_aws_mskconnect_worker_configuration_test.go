@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mskconnect_worker_configuration", &resource.Sweeper{
+		Name: "aws_mskconnect_worker_configuration",
+		F:    testSweepMskConnectWorkerConfigurations,
+	})
+}
+
+func testSweepMskConnectWorkerConfigurations(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*AWSClient).kafkaconnectconn
+
+	out, err := conn.ListWorkerConfigurations(&kafkaconnect.ListWorkerConfigurationsInput{})
+	if err != nil {
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] skipping mskconnect worker configuration sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving MSK Connect Worker Configurations: %s", err)
+	}
+
+	for _, workerConfiguration := range out.WorkerConfigurations {
+		log.Printf("[INFO] Deleting MSK Connect Worker Configuration: %s", *workerConfiguration.WorkerConfigurationArn)
+		_, err := conn.DeleteWorkerConfiguration(&kafkaconnect.DeleteWorkerConfigurationInput{
+			WorkerConfigurationArn: workerConfiguration.WorkerConfigurationArn,
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete MSK Connect Worker Configuration %s: %s", *workerConfiguration.WorkerConfigurationArn, err)
+		}
+	}
+	return nil
+}
+
+func TestAccAWSMskConnectWorkerConfiguration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mskconnect_worker_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskConnectWorkerConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskConnectWorkerConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMskConnectWorkerConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "properties_file_content"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMskConnectWorkerConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconnectconn
+		_, err := conn.DescribeWorkerConfiguration(&kafkaconnect.DescribeWorkerConfigurationInput{
+			WorkerConfigurationArn: &rs.Primary.ID,
+		})
+		return err
+	}
+}
+
+func testAccCheckMskConnectWorkerConfigurationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mskconnect_worker_configuration" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconnectconn
+		_, err := conn.DescribeWorkerConfiguration(&kafkaconnect.DescribeWorkerConfigurationInput{
+			WorkerConfigurationArn: &rs.Primary.ID,
+		})
+		if isMskConnectNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("MSK Connect Worker Configuration %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccMskConnectWorkerConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mskconnect_worker_configuration" "test" {
+  name                     = %[1]q
+  properties_file_content  = <<EOT
+key.converter=org.apache.kafka.connect.storage.StringConverter
+value.converter=org.apache.kafka.connect.storage.StringConverter
+EOT
+}
+`, rName)
+}